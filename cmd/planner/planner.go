@@ -17,6 +17,8 @@ import (
 	"github.com/phenpessoa/gutils/netutils/httputils"
 	"go-plann.er/internal/api"
 	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/chat"
+	"go-plann.er/internal/currency"
 	"go-plann.er/internal/mailer/mailpit"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -68,11 +70,35 @@ func run(ctx context.Context) error {
 		return err
 	}
 
-	si := api.NewAPI(pool, logger, mailpit.NewMailpit(pool))
+	ratesFile := os.Getenv("PLANNER_RATES_FILE")
+	if ratesFile == "" {
+		ratesFile = "rates.csv"
+	}
+
+	notifiers := map[string]api.Notifier{
+		"slack":   chat.NewSlack(),
+		"discord": chat.NewDiscord(),
+	}
+
+	si := api.NewAPI(pool, logger, mailpit.NewMailpit(pool), currency.NewFileProvider(ratesFile), notifiers)
+
+	// Missing rates only keep balances from being converted, so the server
+	// starts anyway and rates can be uploaded later.
+	if n, err := si.RefreshExchangeRates(ctx); err != nil {
+		logger.Warn("failed to load exchange rates", zap.Error(err))
+	} else {
+		logger.Info("loaded exchange rates", zap.Int("count", n))
+	}
+
+	go remindOverdueTasks(ctx, si, logger)
+	go listenTripEvents(ctx, si, logger)
+	go pruneTripEvents(ctx, si, logger)
+	go deliverWebhooks(ctx, si, logger)
+	go postChatNotifications(ctx, si, logger)
 
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
-	r.Mount("/", spec.Handler(&si))
+	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger), si.Idempotency)
+	r.Mount("/", spec.Handler(si.LoadTrips(&si), spec.WithErrorHandler(si.ErrorHandler)))
 	srv := &http.Server{
 		Addr:         ":8080",
 		Handler:      r,
@@ -110,3 +136,111 @@ func run(ctx context.Context) error {
 
 	return nil
 }
+
+// remindOverdueTasks sends the reminders for overdue tasks every hour until ctx
+// is done.
+func remindOverdueTasks(ctx context.Context, si api.API, logger *zap.Logger) {
+	const interval = time.Hour
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := si.RemindOverdueTasks(ctx); err != nil {
+			logger.Error("failed to remind overdue tasks", zap.Error(err))
+		} else if n > 0 {
+			logger.Info("sent overdue task reminders", zap.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// listenTripEvents keeps the API listening for trip events until ctx is done,
+// reconnecting whenever the connection fails.
+func listenTripEvents(ctx context.Context, si api.API, logger *zap.Logger) {
+	const retry = 5 * time.Second
+
+	for {
+		err := si.ListenTripEvents(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Error("stopped listening for trip events", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
+
+// pruneTripEvents deletes the trip events past their retention every hour until
+// ctx is done.
+func pruneTripEvents(ctx context.Context, si api.API, logger *zap.Logger) {
+	const interval = time.Hour
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := si.PruneTripEvents(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("failed to prune trip events", zap.Error(err))
+		} else if n > 0 {
+			logger.Info("pruned trip events", zap.Int64("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverWebhooks attempts the webhook deliveries that are due every few
+// seconds until ctx is done.
+func deliverWebhooks(ctx context.Context, si api.API, logger *zap.Logger) {
+	const interval = 5 * time.Second
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := si.DeliverWebhooks(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("failed to deliver webhooks", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// postChatNotifications posts the trip events waiting for chat channels every
+// few seconds until ctx is done.
+func postChatNotifications(ctx context.Context, si api.API, logger *zap.Logger) {
+	const interval = 5 * time.Second
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := si.PostChatNotifications(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("failed to post chat notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +19,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/chat"
+	"go-plann.er/internal/currency"
 	"go-plann.er/internal/mailer/mailpit"
 	"go-plann.er/internal/pgstore"
 	"go.uber.org/zap"
@@ -24,20 +30,144 @@ type store interface {
 	CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error)
 	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
 	GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error)
-	ConfirmParticipant(ctx context.Context, participantID uuid.UUID) error
-	UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) error
-	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) (int64, error)
+	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivitiesRow, error)
 	CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error)
-	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
+	GetActivity(ctx context.Context, arg pgstore.GetActivityParams) (pgstore.Activity, error)
+	UpdateActivity(ctx context.Context, arg pgstore.UpdateActivityParams) (int64, error)
+	DeleteActivity(ctx context.Context, arg pgstore.DeleteActivityParams) (int64, error)
+	GetActivityAttendees(ctx context.Context, activityID uuid.UUID) ([]pgstore.GetActivityAttendeesRow, error)
+	GetTripActivityAttendees(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityAttendeesRow, error)
+	GetParticipantItinerary(ctx context.Context, id uuid.UUID) ([]pgstore.GetParticipantItineraryRow, error)
+	GetTripReservations(ctx context.Context, tripID uuid.UUID) ([]pgstore.Reservation, error)
+	GetTripLegs(ctx context.Context, tripID uuid.UUID) ([]pgstore.TripLeg, error)
+	GetTripLeg(ctx context.Context, arg pgstore.GetTripLegParams) (pgstore.TripLeg, error)
+	ReplaceTripLegs(ctx context.Context, pool *pgxpool.Pool, params pgstore.BumpTripVersionParams, legs []pgstore.TripLeg) ([]pgstore.TripLeg, error)
+	GetReservation(ctx context.Context, arg pgstore.GetReservationParams) (pgstore.Reservation, error)
+	CreateReservation(ctx context.Context, arg pgstore.CreateReservationParams) (uuid.UUID, error)
+	UpdateReservation(ctx context.Context, arg pgstore.UpdateReservationParams) (int64, error)
+	DeleteReservation(ctx context.Context, arg pgstore.DeleteReservationParams) (int64, error)
+	CreateChecklistWithItems(ctx context.Context, pool *pgxpool.Pool, params pgstore.CreateChecklistParams, items []string) (uuid.UUID, error)
+	GetTripChecklists(ctx context.Context, tripID uuid.UUID) ([]pgstore.Checklist, error)
+	GetTripChecklistItems(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChecklistItem, error)
+	GetChecklist(ctx context.Context, arg pgstore.GetChecklistParams) (pgstore.Checklist, error)
+	DeleteChecklist(ctx context.Context, arg pgstore.DeleteChecklistParams) (int64, error)
+	GetChecklistItems(ctx context.Context, checklistID uuid.UUID) ([]pgstore.ChecklistItem, error)
+	CreateChecklistItem(ctx context.Context, arg pgstore.CreateChecklistItemParams) (uuid.UUID, error)
+	UpdateChecklistItem(ctx context.Context, arg pgstore.UpdateChecklistItemParams) (int64, error)
+	CheckChecklistItem(ctx context.Context, arg pgstore.CheckChecklistItemParams) (int64, error)
+	UncheckChecklistItem(ctx context.Context, arg pgstore.UncheckChecklistItemParams) (int64, error)
+	ReorderChecklistItems(ctx context.Context, arg pgstore.ReorderChecklistItemsParams) error
+	DeleteChecklistItem(ctx context.Context, arg pgstore.DeleteChecklistItemParams) (int64, error)
+	CreateChecklistTemplate(ctx context.Context, arg pgstore.CreateChecklistTemplateParams) (uuid.UUID, error)
+	GetChecklistTemplates(ctx context.Context, ownerEmail string) ([]pgstore.ChecklistTemplate, error)
+	GetChecklistTemplate(ctx context.Context, arg pgstore.GetChecklistTemplateParams) (pgstore.ChecklistTemplate, error)
+	DeleteChecklistTemplate(ctx context.Context, arg pgstore.DeleteChecklistTemplateParams) (int64, error)
+	CreateTask(ctx context.Context, arg pgstore.CreateTaskParams) (uuid.UUID, error)
+	GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Task, error)
+	GetParticipantTasks(ctx context.Context, assigneeID pgtype.UUID) ([]pgstore.Task, error)
+	GetTask(ctx context.Context, arg pgstore.GetTaskParams) (pgstore.Task, error)
+	UpdateTask(ctx context.Context, arg pgstore.UpdateTaskParams) (int64, error)
+	DeleteTask(ctx context.Context, arg pgstore.DeleteTaskParams) (int64, error)
+	ClaimOverdueTasks(ctx context.Context) ([]pgstore.ClaimOverdueTasksRow, error)
+	CreateTaskComment(ctx context.Context, arg pgstore.CreateTaskCommentParams) (uuid.UUID, error)
+	GetTaskComments(ctx context.Context, taskID uuid.UUID) ([]pgstore.TaskComment, error)
+	CreateComment(ctx context.Context, arg pgstore.CreateCommentParams) (uuid.UUID, error)
+	GetComment(ctx context.Context, arg pgstore.GetCommentParams) (pgstore.Comment, error)
+	GetComments(ctx context.Context, arg pgstore.GetCommentsParams) ([]pgstore.GetCommentsRow, error)
+	UpdateComment(ctx context.Context, arg pgstore.UpdateCommentParams) (int64, error)
+	DeleteComment(ctx context.Context, arg pgstore.DeleteCommentParams) (int64, error)
+	GetTripEventsHorizon(ctx context.Context) (int64, error)
+	GetTripEventXactID(ctx context.Context, arg pgstore.GetTripEventXactIDParams) (int64, error)
+	GetTripEvents(ctx context.Context, arg pgstore.GetTripEventsParams) ([]pgstore.TripEvent, error)
+	DeleteOldTripEvents(ctx context.Context, maxAgeSeconds int32) (int64, error)
+	OpenTripSession(ctx context.Context, arg pgstore.OpenTripSessionParams) (uuid.UUID, error)
+	TouchTripSession(ctx context.Context, id uuid.UUID) (int64, error)
+	CloseTripSession(ctx context.Context, id uuid.UUID) error
+	DeleteStaleTripSessions(ctx context.Context, timeoutSeconds int32) error
+	GetTripPresence(ctx context.Context, arg pgstore.GetTripPresenceParams) ([]pgstore.GetTripPresenceRow, error)
+	ClaimActivityLock(ctx context.Context, arg pgstore.ClaimActivityLockParams) (int64, error)
+	ReleaseActivityLock(ctx context.Context, arg pgstore.ReleaseActivityLockParams) error
+	GetActivityLocks(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetActivityLocksRow, error)
+	CreateWebhook(ctx context.Context, arg pgstore.CreateWebhookParams) (uuid.UUID, error)
+	GetTripWebhooks(ctx context.Context, arg pgstore.GetTripWebhooksParams) ([]pgstore.Webhook, error)
+	GetTripWebhook(ctx context.Context, arg pgstore.GetTripWebhookParams) (pgstore.Webhook, error)
+	DeleteTripWebhook(ctx context.Context, arg pgstore.DeleteTripWebhookParams) (int64, error)
+	GetWebhookDeliveries(ctx context.Context, arg pgstore.GetWebhookDeliveriesParams) ([]pgstore.GetWebhookDeliveriesRow, error)
+	GetWebhookAttempts(ctx context.Context, deliveryIds []uuid.UUID) ([]pgstore.WebhookAttempt, error)
+	GetWebhookDelivery(ctx context.Context, arg pgstore.GetWebhookDeliveryParams) (pgstore.WebhookDelivery, error)
+	CreateWebhookDelivery(ctx context.Context, arg pgstore.CreateWebhookDeliveryParams) (uuid.UUID, error)
+	ClaimWebhookDeliveries(ctx context.Context, arg pgstore.ClaimWebhookDeliveriesParams) ([]pgstore.ClaimWebhookDeliveriesRow, error)
+	RecordWebhookAttempt(ctx context.Context, arg pgstore.RecordWebhookAttemptParams) error
+	CreateChatChannel(ctx context.Context, arg pgstore.CreateChatChannelParams) (uuid.UUID, error)
+	GetTripChatChannels(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChatChannel, error)
+	GetTripChatChannel(ctx context.Context, arg pgstore.GetTripChatChannelParams) (pgstore.ChatChannel, error)
+	DeleteTripChatChannel(ctx context.Context, arg pgstore.DeleteTripChatChannelParams) (int64, error)
+	ClaimChatChannels(ctx context.Context, arg pgstore.ClaimChatChannelsParams) ([]pgstore.ClaimChatChannelsRow, error)
+	SaveChatChannelProgress(ctx context.Context, arg pgstore.SaveChatChannelProgressParams) error
+	AttendActivity(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, params pgstore.AddActivityAttendeeParams) error
+	LeaveActivity(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, params pgstore.RemoveActivityAttendeeParams) error
+	GetTripPolls(ctx context.Context, id uuid.UUID) ([]pgstore.GetTripPollsRow, error)
+	GetTripPollOptions(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripPollOptionsRow, error)
+	GetPoll(ctx context.Context, arg pgstore.GetPollParams) (pgstore.Poll, error)
+	GetPollOptions(ctx context.Context, pollID uuid.UUID) ([]pgstore.GetPollOptionsRow, error)
+	OpenPoll(ctx context.Context, pool *pgxpool.Pool, params pgstore.CreatePollParams, options []pgstore.PollOptionParams) (uuid.UUID, error)
+	AddPollOptions(ctx context.Context, pool *pgxpool.Pool, params pgstore.LockPollParams, options []pgstore.PollOptionParams) ([]uuid.UUID, error)
+	CastBallot(ctx context.Context, pool *pgxpool.Pool, params pgstore.LockPollParams, participantID uuid.UUID, votes []pgstore.CastPollVoteParams) error
+	ClosePoll(ctx context.Context, pool *pgxpool.Pool, params pgstore.LockPollParams) (pgstore.GetPollOptionsRow, error)
+	GetTripExpenses(ctx context.Context, id uuid.UUID) ([]pgstore.GetTripExpensesRow, error)
+	GetTripExpenseSplits(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseSplitsRow, error)
+	GetExpense(ctx context.Context, arg pgstore.GetExpenseParams) (pgstore.Expense, error)
+	GetExpenseSplits(ctx context.Context, expenseID uuid.UUID) ([]pgstore.ExpenseSplit, error)
+	DeleteExpense(ctx context.Context, arg pgstore.DeleteExpenseParams) (int64, error)
+	LogExpense(ctx context.Context, pool *pgxpool.Pool, params pgstore.CreateExpenseParams, splits []pgstore.CreateExpenseSplitsParams) (uuid.UUID, error)
+	GetExchangeRatesOn(ctx context.Context, effectiveOn pgtype.Date) ([]pgstore.ExchangeRate, error)
+	GetTripBudgets(ctx context.Context, tripID uuid.UUID) ([]pgstore.Budget, error)
+	GetBudget(ctx context.Context, arg pgstore.GetBudgetParams) (pgstore.Budget, error)
+	UpsertBudget(ctx context.Context, arg pgstore.UpsertBudgetParams) error
+	DeleteBudget(ctx context.Context, arg pgstore.DeleteBudgetParams) (int64, error)
+	MarkBudgetAlerted(ctx context.Context, arg pgstore.MarkBudgetAlertedParams) (int64, error)
+	SaveExchangeRates(ctx context.Context, pool *pgxpool.Pool, rates []pgstore.UpsertExchangeRateParams) error
+	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripLinksRow, error)
 	CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error)
-	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
-	InviteParticipantsToTrip(ctx context.Context, arg []pgstore.InviteParticipantsToTripParams) (int64, error)
+	GetTripLink(ctx context.Context, arg pgstore.GetTripLinkParams) (pgstore.Link, error)
+	UpdateTripLink(ctx context.Context, arg pgstore.UpdateTripLinkParams) (int64, error)
+	DeleteTripLink(ctx context.Context, arg pgstore.DeleteTripLinkParams) (int64, error)
+	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetParticipantsRow, error)
+	GetTripOverview(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (pgstore.TripOverview, error)
+	InviteParticipants(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, invites []pgstore.InviteParticipantsToTripParams) ([]pgstore.InviteParticipantsToTripParams, error)
+	MarkParticipantsInvited(ctx context.Context, arg pgstore.MarkParticipantsInvitedParams) error
+	ClaimParticipantInvite(ctx context.Context, arg pgstore.ClaimParticipantInviteParams) (int64, error)
+	AcceptInvite(ctx context.Context, pool *pgxpool.Pool, participant pgstore.Participant) (bool, error)
+	DeclineInvite(ctx context.Context, pool *pgxpool.Pool, participant pgstore.Participant) ([]pgstore.Participant, error)
+	RemoveParticipant(ctx context.Context, pool *pgxpool.Pool, participant pgstore.Participant) ([]pgstore.Participant, error)
+	PromoteWaitlist(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) ([]pgstore.Participant, error)
+	CreateJoinLink(ctx context.Context, arg pgstore.CreateJoinLinkParams) (uuid.UUID, error)
+	GetTripJoinLinks(ctx context.Context, id uuid.UUID) ([]pgstore.GetTripJoinLinksRow, error)
+	RevokeJoinLink(ctx context.Context, arg pgstore.RevokeJoinLinkParams) (int64, error)
+	JoinTrip(ctx context.Context, pool *pgxpool.Pool, params pgstore.JoinTripParams) (pgstore.Participant, error)
+	CreateIdempotencyKey(ctx context.Context, arg pgstore.CreateIdempotencyKeyParams) (int64, error)
+	GetIdempotencyKey(ctx context.Context, key string) (pgstore.IdempotencyKey, error)
+	SaveIdempotencyKeyResponse(ctx context.Context, arg pgstore.SaveIdempotencyKeyResponseParams) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
 }
 
 type Mailer interface {
 	SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error
 	SendConfirmTripEmailToTripParticipants(participants []mailpit.ParticipantToSendEmail, tripID uuid.UUID) error
 	SendConfirmTripEmailToTripParticipant(participant mailpit.ParticipantToSendEmail, tripID uuid.UUID) error
+	SendInviteRevokedEmailToParticipant(participant mailpit.ParticipantToSendEmail, tripID uuid.UUID) error
+	SendPromotedFromWaitlistEmailToParticipant(participant mailpit.ParticipantToSendEmail, tripID uuid.UUID) error
+	SendBudgetAlertEmailToTripOwner(alert mailpit.BudgetAlert, tripID uuid.UUID) error
+	SendTaskReminderEmail(recipient mailpit.ParticipantToSendEmail, task mailpit.TaskReminder, tripID uuid.UUID) error
+	SendCommentMentionEmail(recipient mailpit.ParticipantToSendEmail, mention mailpit.CommentMention, tripID uuid.UUID) error
+}
+
+// Notifier posts trip notifications to a chat platform through one of its
+// incoming webhooks, formatted the way the platform shows them. Its errors
+// tell how to handle a failure, see package chat.
+type Notifier interface {
+	Notify(ctx context.Context, webhookURL string, notification chat.Notification) error
 }
 
 type API struct {
@@ -46,11 +176,16 @@ type API struct {
 	validator *validator.Validate
 	pool      *pgxpool.Pool
 	mailer    Mailer
+	rates     currency.Provider
+	events    *broker
+	presence  *broker
+	webhooks  *http.Client
+	// notifiers are the chat platforms by name, as stored with chat channels.
+	notifiers map[string]Notifier
 }
 
-func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer) API {
-	validator := validator.New(validator.WithRequiredStructEnabled())
-	return API{pgstore.New(pool), logger, validator, pool, mailer}
+func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer, rates currency.Provider, notifiers map[string]Notifier) API {
+	return API{pgstore.New(pool), logger, newValidator(), pool, mailer, rates, newBroker(), newBroker(), newWebhookClient(), notifiers}
 }
 
 // Confirms a participant on a trip.
@@ -58,32 +193,31 @@ func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer) API {
 func (api API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
 	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "invalid UUID"})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participant not found"})
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
 		}
 
 		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
 	}
 
 	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "participant already confirmed",
-		})
+		return api.problem(w, r, errConflict("participant_already_confirmed", "participant already confirmed"))
 	}
 
-	if err := api.store.ConfirmParticipant(r.Context(), id); err != nil {
+	waitlisted, err := api.store.AcceptInvite(r.Context(), api.pool, participant)
+	if err != nil {
 		api.logger.Error("failed to confirm participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
+	}
+
+	if waitlisted {
+		return spec.PatchParticipantsParticipantIDConfirmJSON202Response(nil)
 	}
 
 	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
@@ -91,19 +225,20 @@ func (api API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *h
 
 // Create a new trip
 // (POST /trips)
-func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+func (api API) PostTrips(w http.ResponseWriter, r *http.Request, _ spec.PostTripsParams) *spec.Response {
 	var body spec.CreateTripRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid json"})
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return api.problem(w, r, errValidation(err))
 	}
 
 	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+		api.logger.Error("failed to create trip", zap.Error(err))
+		return api.problem(w, r, errInternal)
 	}
 
 	go func() {
@@ -121,124 +256,257 @@ func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response
 
 // Get a trip details.
 // (GET /trips/{tripId})
-func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid UUID"})
+func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParams) *spec.Response {
+	trip := tripFromContext(r.Context())
+	if resp := conditionalGet(w, params.IfNoneMatch, versionETag(trip.Version)); resp != nil {
+		return resp
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "trip not found"})
-		}
-
-		api.logger.Error("failed to get trips", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	legs, ok := api.tripLegs(w, r, trip.ID)
+	if !ok {
+		return nil
 	}
 
 	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
-		Trip: spec.GetTripDetailsResponseTripObj{
-			ID:          trip.ID.String(),
-			Destination: trip.Destination,
-			StartsAt:    trip.StartsAt.Time,
-			EndsAt:      trip.EndsAt.Time,
-			IsConfirmed: trip.IsConfirmed,
-		},
+		Trip: tripResponse(trip, legs),
 	})
 }
 
 // Update a trip.
 // (PUT /trips/{tripId})
-func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
 	var body spec.UpdateTripRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid json"})
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid inputs: " + err.Error()})
+		return api.problem(w, r, errValidation(err))
 	}
 
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid UUID"})
+	trip := tripFromContext(r.Context())
+	if preconditionFailed(params.IfMatch, trip.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	legs, ok := api.tripLegs(w, r, trip.ID)
+	if !ok {
+		return nil
+	}
+
+	if !routeFits(legs, body.StartsAt, body.EndsAt) {
+		return api.problem(w, r, errRouteOutsideTrip)
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), id)
+	updated, err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
+		Destination:  body.Destination,
+		StartsAt:     pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		EndsAt:       pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		IsConfirmed:  trip.IsConfirmed,
+		Capacity:     int4FromInt(body.Capacity),
+		HomeCurrency: text(body.HomeCurrency),
+		ID:           trip.ID,
+		Version:      trip.Version,
+	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "trip not found"})
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errHomeCurrencyHasBudgets)
 		}
 
-		api.logger.Error("failed to get trips", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.logger.Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
 	}
 
-	if err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
-		Destination: body.Destination,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
-		IsConfirmed: trip.IsConfirmed,
-		ID:          trip.ID,
-	}); err != nil {
-		api.logger.Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	if capacityRaised(trip.Capacity, int4FromInt(body.Capacity)) {
+		api.promoteWaitlist(r, trip.ID)
 	}
 
+	w.Header().Set("ETag", versionETag(trip.Version+1))
 	return spec.PutTripsTripIDJSON204Response(nil)
 }
 
-// Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// Partially update a trip.
+// (PATCH /trips/{tripId})
+func (api API) PatchTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDParams) *spec.Response {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != mergePatchContentType {
+		return api.problem(w, r, errUnsupportedMediaType("content type must be "+mergePatchContentType))
+	}
+
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	trip := tripFromContext(r.Context())
+	if preconditionFailed(params.IfMatch, trip.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	current, err := json.Marshal(spec.UpdateTripRequest{
+		Destination:  trip.Destination,
+		StartsAt:     trip.StartsAt.Time,
+		EndsAt:       trip.EndsAt.Time,
+		Capacity:     intFromInt4(trip.Capacity),
+		HomeCurrency: stringFromText(trip.HomeCurrency),
+	})
+	if err != nil {
+		api.logger.Error("failed to encode trip", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	merged, err := mergePatch(current, patch)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	var body spec.UpdateTripRequest
+	dec := json.NewDecoder(bytes.NewReader(merged))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	legs, ok := api.tripLegs(w, r, trip.ID)
+	if !ok {
+		return nil
+	}
+
+	if !routeFits(legs, body.StartsAt, body.EndsAt) {
+		return api.problem(w, r, errRouteOutsideTrip)
+	}
+
+	updated, err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
+		Destination:  body.Destination,
+		StartsAt:     pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		EndsAt:       pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		IsConfirmed:  trip.IsConfirmed,
+		Capacity:     int4FromInt(body.Capacity),
+		HomeCurrency: text(body.HomeCurrency),
+		ID:           trip.ID,
+		Version:      trip.Version,
+	})
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errHomeCurrencyHasBudgets)
+		}
+
+		api.logger.Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	if capacityRaised(trip.Capacity, int4FromInt(body.Capacity)) {
+		api.promoteWaitlist(r, trip.ID)
+	}
+
+	trip.Destination = body.Destination
+	trip.StartsAt = pgtype.Timestamp{Valid: true, Time: body.StartsAt}
+	trip.EndsAt = pgtype.Timestamp{Valid: true, Time: body.EndsAt}
+	trip.Capacity = int4FromInt(body.Capacity)
+	trip.HomeCurrency = text(body.HomeCurrency)
+	trip.Version++
+
+	w.Header().Set("ETag", versionETag(trip.Version))
+	return spec.PatchTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
+		Trip: tripResponse(trip, legs),
+	})
+}
+
+// Get a trip with its participants, activities and links.
+// (GET /trips/{tripId}/overview)
+func (api API) GetTripsTripIDOverview(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDOverviewParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid UUID"})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
-	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+	overview, err := api.store.GetTripOverview(r.Context(), api.pool, id)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "trip not found"})
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
 		}
 
-		api.logger.Error("failed to get trips", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.logger.Error("failed to get trip overview", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	participants := participantsResponse(overview.Participants)
+	activities := activitiesResponse(overview.Activities, activityAttendees(overview.Attendees), overview.Reservations)
+	links := linksResponse(overview.Links)
+
+	summary := spec.GetTripOverviewResponseSummary{
+		Participants: len(participants),
+		Links:        len(links),
+	}
+	for _, participant := range participants {
+		if participant.IsConfirmed {
+			summary.ConfirmedParticipants++
+		}
+	}
+	for _, day := range activities {
+		summary.Activities += len(day.Activities)
+	}
+
+	response := spec.GetTripOverviewResponse{
+		Trip:         tripResponse(overview.Trip, overview.Legs),
+		Participants: participants,
+		Activities:   activities,
+		Links:        links,
+		Summary:      summary,
+	}
+	if resp := conditionalGet(w, params.IfNoneMatch, contentETag(response)); resp != nil {
+		return resp
+	}
+
+	return spec.GetTripsTripIDOverviewJSON200Response(response)
+}
+
+// Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	activities, err := api.store.GetTripActivities(r.Context(), id)
 	if err != nil {
 		api.logger.Error("failed to get trips activities", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
 	}
 
-	activityMap := make(map[string][]spec.GetTripActivitiesResponseInnerArray)
-	for _, activity := range activities {
-		date := activity.OccursAt.Time.Format(time.DateOnly)
-		activityMap[date] = append(activityMap[date], spec.GetTripActivitiesResponseInnerArray{
-			ID:       activity.ID.String(),
-			OccursAt: activity.OccursAt.Time,
-			Title:    activity.Title,
-		})
+	if len(activities) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
 	}
 
-	var response spec.GetTripActivitiesResponse
-	for date, activities := range activityMap {
-		parsedDate, _ := time.Parse(time.DateOnly, date)
-		response.Activities = append(response.Activities, spec.GetTripActivitiesResponseOuterArray{
-			Date:       parsedDate,
-			Activities: activities,
-		})
+	attendees, err := api.store.GetTripActivityAttendees(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activity attendees", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	reservations, err := api.store.GetTripReservations(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip reservations", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetTripActivitiesResponse{
+		Activities: activitiesResponse(activities, activityAttendees(attendees), reservations),
+	}
+	if resp := conditionalGet(w, params.IfNoneMatch, contentETag(response)); resp != nil {
+		return resp
 	}
 
 	return spec.GetTripsTripIDActivitiesJSON200Response(response)
@@ -246,42 +514,43 @@ func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request,
 
 // Create a trip activity.
 // (POST /trips/{tripId}/activities)
-func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, _ spec.PostTripsTripIDActivitiesParams) *spec.Response {
 	var body spec.CreateActivityRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid json"})
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid inputs: " + err.Error()})
+		return api.problem(w, r, errValidation(err))
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid UUID"})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
-	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "trip not found"})
-		}
-
-		api.logger.Error("failed to create trip activities", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	legID, ok := api.activityLeg(w, r, id, body.LegID, body.OccursAt)
+	if !ok {
+		return nil
 	}
 
-	activityID, err := api.store.CreateActivity(r.Context(), pgstore.CreateActivityParams{
+	params := pgstore.CreateActivityParams{
 		TripID:   id,
 		Title:    body.Title,
 		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
-	})
+		Capacity: int4FromInt(body.Capacity),
+		LegID:    legID,
+	}
+	params.EstimatedCost, params.EstimatedCurrency = estimate(body.EstimatedCost)
+
+	activityID, err := api.store.CreateActivity(r.Context(), params)
 	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
 		api.logger.Error("failed to create activity", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
 	}
 
 	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
@@ -289,43 +558,199 @@ func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request,
 	})
 }
 
-// Confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Get a trip activity.
+// (GET /trips/{tripId}/activities/{activityId})
+func (api API) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.GetTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	activity, ok := api.getActivity(w, r, tripID, activityID)
+	if !ok {
+		return nil
+	}
+
+	if resp := conditionalGet(w, params.IfNoneMatch, versionETag(activity.Version)); resp != nil {
+		return resp
+	}
+
+	rows, err := api.store.GetActivityAttendees(r.Context(), activity.ID)
 	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "invalid UUID"})
+		api.logger.Error("failed to get activity attendees", zap.Error(err), zap.String("activity_id", activityID))
+		return api.problem(w, r, errInternal)
 	}
 
-	if _, err = api.store.GetTrip(r.Context(), id); err != nil {
+	attendees := make([]spec.ActivityAttendee, 0, len(rows))
+	for _, row := range rows {
+		attendees = append(attendees, attendeeResponse(row.ID, row.Email, row.Name))
+	}
+
+	return spec.GetTripsTripIDActivitiesActivityIDJSON200Response(spec.GetActivityResponse{
+		Activity: spec.GetTripActivitiesResponseInnerArray{
+			ID:            activity.ID.String(),
+			OccursAt:      activity.OccursAt.Time,
+			Title:         activity.Title,
+			Capacity:      intFromInt4(activity.Capacity),
+			EstimatedCost: moneyResponse(activity.EstimatedCost, activity.EstimatedCurrency),
+			LegID:         stringFromUUID(activity.LegID),
+			Attendees:     attendees,
+		},
+	})
+}
+
+// Update a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api API) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.PutTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	var body spec.UpdateActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	activity, ok := api.getActivity(w, r, tripID, activityID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, activity.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	legID, ok := api.activityLeg(w, r, activity.TripID, body.LegID, body.OccursAt)
+	if !ok {
+		return nil
+	}
+
+	update := pgstore.UpdateActivityParams{
+		Title:    body.Title,
+		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+		Capacity: int4FromInt(body.Capacity),
+		LegID:    legID,
+		ID:       activity.ID,
+		TripID:   activity.TripID,
+		Version:  activity.Version,
+	}
+	update.EstimatedCost, update.EstimatedCurrency = estimate(body.EstimatedCost)
+
+	updated, err := api.store.UpdateActivity(r.Context(), update)
+	if err != nil {
+		api.logger.Error("failed to update activity", zap.Error(err), zap.String("activity_id", activityID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	w.Header().Set("ETag", versionETag(activity.Version+1))
+	return spec.PutTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.DeleteTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	activity, ok := api.getActivity(w, r, tripID, activityID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, activity.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	deleted, err := api.store.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{
+		ID:      activity.ID,
+		TripID:  activity.TripID,
+		Version: activity.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete activity", zap.Error(err), zap.String("activity_id", activityID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// getActivity loads an activity of a trip. When it reports false the problem
+// has already been written.
+func (api API) getActivity(w http.ResponseWriter, r *http.Request, tripID, activityID string) (pgstore.Activity, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Activity{}, false
+	}
+
+	aid, err := uuid.Parse(activityID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Activity{}, false
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: aid, TripID: tid})
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "trip not found"})
+			api.problem(w, r, errNotFound("activity_not_found", "activity not found"))
+			return pgstore.Activity{}, false
 		}
 
-		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.logger.Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		api.problem(w, r, errInternal)
+		return pgstore.Activity{}, false
+	}
+
+	return activity, true
+}
+
+// Confirm a trip and send e-mail invitations.
+// (GET /trips/{tripId}/confirm)
+func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	participants, err := api.store.GetParticipants(r.Context(), id)
 	if err != nil {
 		api.logger.Error("failed to get trip participants", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(participants) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
 	}
 
 	var mailerParticipants []mailpit.ParticipantToSendEmail
 	for _, participant := range participants {
-		name := participant.Email[:strings.LastIndex(participant.Email, "@")]
+		// Participants who already confirmed aren't asked again, so their
+		// invite quota is left for the resends they ask for.
+		if !participant.ID.Valid || participant.IsConfirmed.Bool {
+			continue
+		}
+
+		name := participant.Name.String
+		if !participant.Name.Valid {
+			name = participant.Email.String[:strings.LastIndex(participant.Email.String, "@")]
+		}
 
 		mailerParticipants = append(mailerParticipants, mailpit.ParticipantToSendEmail{
-			Email: participant.Email,
+			Email: participant.Email.String,
 			Name:  name,
 		})
 	}
 
+	if len(mailerParticipants) == 0 {
+		return spec.GetTripsTripIDConfirmJSON204Response(nil)
+	}
+
+	emails := make([]string, len(mailerParticipants))
+	for i, participant := range mailerParticipants {
+		emails[i] = participant.Email
+	}
+	api.markInvited(r.Context(), id, emails)
+
 	go func() {
 		if err := api.mailer.SendConfirmTripEmailToTripParticipants(mailerParticipants, id); err != nil {
 			api.logger.Error(
@@ -341,45 +766,45 @@ func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tri
 
 // Invite someone to the trip.
 // (POST /trips/{tripId}/invites)
-func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string, _ spec.PostTripsTripIDInvitesParams) *spec.Response {
 	var body spec.InviteParticipantRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid json"})
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return api.problem(w, r, errValidation(err))
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid UUID"})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
-	if _, err = api.store.GetTrip(r.Context(), id); err != nil {
+	// Going through the same transaction as bulk invites skips the owner and
+	// the people already on the trip, whatever the case of their email.
+	created, err := api.store.InviteParticipants(r.Context(), api.pool, id, []pgstore.InviteParticipantsToTripParams{
+		{Email: string(body.Email)},
+	})
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "trip not found"})
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
 		}
 
-		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
-	}
-
-	var participantTrip []pgstore.InviteParticipantsToTripParams
-	participantTrip = append(participantTrip, pgstore.InviteParticipantsToTripParams{
-		TripID: id,
-		Email:  string(body.Email),
-	})
+		if pgstore.IsUniqueViolation(err) {
+			return api.problem(w, r, errConflict("participant_already_invited", "participant already invited"))
+		}
 
-	if _, err := api.store.InviteParticipantsToTrip(r.Context(), participantTrip); err != nil {
 		api.logger.Error("failed to invite participants to trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(created) == 0 {
+		return api.problem(w, r, errConflict("participant_already_invited", "already on the trip"))
 	}
 
+	api.markInvited(r.Context(), id, []string{string(body.Email)})
+
 	go func() {
 		if err := api.mailer.SendConfirmTripEmailToTripParticipant(mailpit.ParticipantToSendEmail{
 			Name:  string(body.Email[:strings.LastIndex(string(body.Email), "@")]),
@@ -398,71 +823,47 @@ func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tr
 
 // Get a trip links.
 // (GET /trips/{tripId}/links)
-func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDLinksParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid UUID"})
-	}
-
-	if _, err = api.store.GetTrip(r.Context(), id); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "trip not found"})
-		}
-
-		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	links, err := api.store.GetTripLinks(r.Context(), id)
 	if err != nil {
 		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
 	}
 
-	var linksResponse []spec.GetLinksResponseArray
-	for _, link := range links {
-		linksResponse = append(linksResponse, spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		})
+	if len(links) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
 	}
 
-	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
-		Links: linksResponse,
-	})
+	response := spec.GetLinksResponse{
+		Links: linksResponse(links),
+	}
+	if resp := conditionalGet(w, params.IfNoneMatch, contentETag(response)); resp != nil {
+		return resp
+	}
+
+	return spec.GetTripsTripIDLinksJSON200Response(response)
 }
 
 // Create a trip link.
 // (POST /trips/{tripId}/links)
-func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, _ spec.PostTripsTripIDLinksParams) *spec.Response {
 	var body spec.CreateLinkRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid json"})
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid inputs: " + err.Error()})
+		return api.problem(w, r, errValidation(err))
 	}
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid UUID"})
-	}
-
-	if _, err = api.store.GetTrip(r.Context(), id); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "trip not found"})
-		}
-
-		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	linkID, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
@@ -471,10 +872,12 @@ func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, trip
 		Url:    body.URL,
 	})
 	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
 		api.logger.Error("failed to create trip link", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return api.problem(w, r, errInternal)
 	}
 
 	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
@@ -482,46 +885,273 @@ func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, trip
 	})
 }
 
-// Get a trip participants.
-// (GET /trips/{tripId}/participants)
-func (api API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Get a trip link.
+// (GET /trips/{tripId}/links/{linkId})
+func (api API) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.GetTripsTripIDLinksLinkIDParams) *spec.Response {
+	link, ok := api.getLink(w, r, tripID, linkID)
+	if !ok {
+		return nil
+	}
+
+	if resp := conditionalGet(w, params.IfNoneMatch, versionETag(link.Version)); resp != nil {
+		return resp
+	}
+
+	return spec.GetTripsTripIDLinksLinkIDJSON200Response(spec.GetLinkResponse{
+		Link: spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		},
+	})
+}
+
+// Update a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api API) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.PutTripsTripIDLinksLinkIDParams) *spec.Response {
+	var body spec.UpdateLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	link, ok := api.getLink(w, r, tripID, linkID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, link.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	updated, err := api.store.UpdateTripLink(r.Context(), pgstore.UpdateTripLinkParams{
+		Title:   body.Title,
+		Url:     body.URL,
+		ID:      link.ID,
+		TripID:  link.TripID,
+		Version: link.Version,
+	})
 	if err != nil {
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid UUID"})
+		api.logger.Error("failed to update trip link", zap.Error(err), zap.String("link_id", linkID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	w.Header().Set("ETag", versionETag(link.Version+1))
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api API) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.DeleteTripsTripIDLinksLinkIDParams) *spec.Response {
+	link, ok := api.getLink(w, r, tripID, linkID)
+	if !ok {
+		return nil
 	}
 
-	if _, err = api.store.GetTrip(r.Context(), id); err != nil {
+	if preconditionFailed(params.IfMatch, link.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	deleted, err := api.store.DeleteTripLink(r.Context(), pgstore.DeleteTripLinkParams{
+		ID:      link.ID,
+		TripID:  link.TripID,
+		Version: link.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete trip link", zap.Error(err), zap.String("link_id", linkID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// getLink loads a link of a trip. When it reports false the problem has
+// already been written.
+func (api API) getLink(w http.ResponseWriter, r *http.Request, tripID, linkID string) (pgstore.Link, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Link{}, false
+	}
+
+	lid, err := uuid.Parse(linkID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Link{}, false
+	}
+
+	link, err := api.store.GetTripLink(r.Context(), pgstore.GetTripLinkParams{ID: lid, TripID: tid})
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "trip not found"})
+			api.problem(w, r, errNotFound("link_not_found", "link not found"))
+			return pgstore.Link{}, false
 		}
 
-		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		api.logger.Error("failed to get trip link", zap.Error(err), zap.String("link_id", linkID))
+		api.problem(w, r, errInternal)
+		return pgstore.Link{}, false
+	}
+
+	return link, true
+}
+
+// Get a trip participants.
+// (GET /trips/{tripId}/participants)
+func (api API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParticipantsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
 	}
 
 	participants, err := api.store.GetParticipants(r.Context(), id)
 	if err != nil {
 		api.logger.Error("failed to get trip participants", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(participants) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+	}
+
+	response := spec.GetTripParticipantsResponse{
+		Participants: participantsResponse(participants),
+	}
+	if resp := conditionalGet(w, params.IfNoneMatch, contentETag(response)); resp != nil {
+		return resp
+	}
+
+	return spec.GetTripsTripIDParticipantsJSON200Response(response)
+}
+
+func tripResponse(trip pgstore.Trip, legs []pgstore.TripLeg) spec.GetTripDetailsResponseTripObj {
+	return spec.GetTripDetailsResponseTripObj{
+		ID:           trip.ID.String(),
+		Destination:  trip.Destination,
+		StartsAt:     trip.StartsAt.Time,
+		EndsAt:       trip.EndsAt.Time,
+		IsConfirmed:  trip.IsConfirmed,
+		Capacity:     intFromInt4(trip.Capacity),
+		HomeCurrency: stringFromText(trip.HomeCurrency),
+		Route:        routeResponse(legs),
+	}
+}
+
+// activitiesResponse groups activities by the day they occur on, in
+// chronological order, along with who signed up for each.
+func activitiesResponse(activities []pgstore.GetTripActivitiesRow, attendees map[uuid.UUID][]spec.ActivityAttendee, reservations []pgstore.Reservation) []spec.GetTripActivitiesResponseOuterArray {
+	days := make(map[string]*spec.GetTripActivitiesResponseOuterArray)
+	day := func(t time.Time) *spec.GetTripActivitiesResponseOuterArray {
+		date := t.Format(time.DateOnly)
+		if days[date] == nil {
+			parsedDate, _ := time.Parse(time.DateOnly, date)
+			days[date] = &spec.GetTripActivitiesResponseOuterArray{
+				Date:         parsedDate,
+				Activities:   []spec.GetTripActivitiesResponseInnerArray{},
+				Reservations: []spec.Reservation{},
+			}
+		}
+		return days[date]
+	}
+
+	for _, activity := range activities {
+		if !activity.ID.Valid {
+			continue
+		}
+
+		id := uuid.UUID(activity.ID.Bytes)
+		activityAttendees := attendees[id]
+		if activityAttendees == nil {
+			activityAttendees = []spec.ActivityAttendee{}
+		}
+
+		d := day(activity.OccursAt.Time)
+		d.Activities = append(d.Activities, spec.GetTripActivitiesResponseInnerArray{
+			ID:            id.String(),
+			OccursAt:      activity.OccursAt.Time,
+			Title:         activity.Title.String,
+			Capacity:      intFromInt4(activity.Capacity),
+			EstimatedCost: moneyResponse(activity.EstimatedCost, activity.EstimatedCurrency),
+			LegID:         stringFromUUID(activity.LegID),
+			Attendees:     activityAttendees,
 		})
 	}
 
-	var responseParticipantsBody []spec.GetTripParticipantsResponseArray
+	// Reservations come sorted by starts_at from the store.
+	for _, reservation := range reservations {
+		d := day(reservation.StartsAt.Time)
+		d.Reservations = append(d.Reservations, reservationResponse(reservation))
+	}
+
+	response := make([]spec.GetTripActivitiesResponseOuterArray, 0, len(days))
+	for _, d := range days {
+		slices.SortFunc(d.Activities, func(a, b spec.GetTripActivitiesResponseInnerArray) int {
+			return a.OccursAt.Compare(b.OccursAt)
+		})
+		response = append(response, *d)
+	}
+
+	slices.SortFunc(response, func(a, b spec.GetTripActivitiesResponseOuterArray) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return response
+}
+
+func participantsResponse(participants []pgstore.GetParticipantsRow) []spec.GetTripParticipantsResponseArray {
+	positions := waitlistPositions(participants)
+
+	response := make([]spec.GetTripParticipantsResponseArray, 0, len(participants))
 	for _, participant := range participants {
-		name := participant.Email[:strings.LastIndex(participant.Email, "@")]
+		if !participant.ID.Valid {
+			continue
+		}
 
-		responseParticipantsBody = append(responseParticipantsBody, spec.GetTripParticipantsResponseArray{
-			Email:       types.Email(participant.Email),
-			ID:          participant.ID.String(),
-			IsConfirmed: participant.IsConfirmed,
+		name := participant.Name.String
+		if !participant.Name.Valid {
+			name = participant.Email.String[:strings.LastIndex(participant.Email.String, "@")]
+		}
+
+		item := spec.GetTripParticipantsResponseArray{
+			Email:       types.Email(participant.Email.String),
+			ID:          uuid.UUID(participant.ID.Bytes).String(),
+			IsConfirmed: participant.IsConfirmed.Bool,
 			Name:        &name,
+			Role:        participantRole(participant.Role.String),
+		}
+		if position, ok := positions[participant.ID.Bytes]; ok {
+			item.WaitlistPosition = &position
+		}
+
+		response = append(response, item)
+	}
+
+	return response
+}
+
+func linksResponse(links []pgstore.GetTripLinksRow) []spec.GetLinksResponseArray {
+	response := make([]spec.GetLinksResponseArray, 0, len(links))
+	for _, link := range links {
+		if !link.ID.Valid {
+			continue
+		}
+
+		response = append(response, spec.GetLinksResponseArray{
+			ID:    uuid.UUID(link.ID.Bytes).String(),
+			Title: link.Title.String,
+			URL:   link.Url.String,
 		})
 	}
 
-	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
-		Participants: responseParticipantsBody,
-	})
+	return response
 }
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Sign a participant up for an activity.
+// (PUT /trips/{tripId}/activities/{activityId}/attendees/{participantId})
+func (api API) PutTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *spec.Response {
+	params, tid, ok := api.attendeeParams(w, r, tripID, activityID, participantID)
+	if !ok {
+		return nil
+	}
+
+	participant, ok := api.tripParticipant(w, r, tid, params.ParticipantID)
+	if !ok {
+		return nil
+	}
+
+	if participant.WaitlistedAt.Valid {
+		return api.problem(w, r, errConflict("participant_waitlisted", "participant is on the trip's waitlist"))
+	}
+
+	if err := api.store.AttendActivity(r.Context(), api.pool, tid, params); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("activity_not_found", "activity not found"))
+		case errors.Is(err, pgstore.ErrActivityFull):
+			return api.problem(w, r, errConflict("activity_full", "activity is full"))
+		case pgstore.IsForeignKeyViolation(err):
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to attend activity", zap.Error(err), zap.String("activity_id", activityID), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response(nil)
+}
+
+// Take a participant off an activity.
+// (DELETE /trips/{tripId}/activities/{activityId}/attendees/{participantId})
+func (api API) DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *spec.Response {
+	params, tid, ok := api.attendeeParams(w, r, tripID, activityID, participantID)
+	if !ok {
+		return nil
+	}
+
+	err := api.store.LeaveActivity(r.Context(), api.pool, tid, pgstore.RemoveActivityAttendeeParams(params))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("activity_not_found", "activity not found"))
+		case errors.Is(err, pgstore.ErrNotAttending):
+			return api.problem(w, r, errNotFound("attendee_not_found", "participant is not attending the activity"))
+		}
+
+		api.logger.Error("failed to leave activity", zap.Error(err), zap.String("activity_id", activityID), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response(nil)
+}
+
+// Get the activities a participant signed up for.
+// (GET /participants/{participantId}/itinerary)
+func (api API) GetParticipantsParticipantIDItinerary(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	rows, err := api.store.GetParticipantItinerary(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participant itinerary", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(rows) == 0 {
+		return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+	}
+
+	attendees, err := api.store.GetTripActivityAttendees(r.Context(), rows[0].TripID)
+	if err != nil {
+		api.logger.Error("failed to get activity attendees", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	reservations, err := api.store.GetTripReservations(r.Context(), rows[0].TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip reservations", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	activities := make([]pgstore.GetTripActivitiesRow, 0, len(rows))
+	for _, row := range rows {
+		activities = append(activities, pgstore.GetTripActivitiesRow(row))
+	}
+
+	return spec.GetParticipantsParticipantIDItineraryJSON200Response(spec.GetTripActivitiesResponse{
+		Activities: activitiesResponse(activities, activityAttendees(attendees), reservations),
+	})
+}
+
+// attendeeParams parses the ids of an attendee route. When it reports false
+// the problem has already been written.
+func (api API) attendeeParams(w http.ResponseWriter, r *http.Request, tripID, activityID, participantID string) (pgstore.AddActivityAttendeeParams, uuid.UUID, bool) {
+	var params pgstore.AddActivityAttendeeParams
+	tid, err := uuid.Parse(tripID)
+	if err == nil {
+		params.ActivityID, err = uuid.Parse(activityID)
+	}
+	if err == nil {
+		params.ParticipantID, err = uuid.Parse(participantID)
+	}
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.AddActivityAttendeeParams{}, uuid.Nil, false
+	}
+
+	return params, tid, true
+}
+
+// tripParticipant loads a participant, treating one from another trip as not
+// found. When it reports false the problem has already been written.
+func (api API) tripParticipant(w http.ResponseWriter, r *http.Request, tripID, participantID uuid.UUID) (pgstore.Participant, bool) {
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID.String()))
+		api.problem(w, r, errInternal)
+		return pgstore.Participant{}, false
+	}
+
+	if err != nil || participant.TripID != tripID {
+		api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		return pgstore.Participant{}, false
+	}
+
+	return participant, true
+}
+
+// activityAttendees groups the attendees of a trip by activity, keeping the
+// order they signed up in.
+func activityAttendees(rows []pgstore.GetTripActivityAttendeesRow) map[uuid.UUID][]spec.ActivityAttendee {
+	attendees := make(map[uuid.UUID][]spec.ActivityAttendee)
+	for _, row := range rows {
+		attendees[row.ActivityID] = append(attendees[row.ActivityID], attendeeResponse(row.ID, row.Email, row.Name))
+	}
+	return attendees
+}
+
+func attendeeResponse(id uuid.UUID, email string, name pgtype.Text) spec.ActivityAttendee {
+	return spec.ActivityAttendee{
+		ID:    id.String(),
+		Name:  participantName(pgstore.Participant{Email: email, Name: name}),
+		Email: types.Email(email),
+	}
+}
@@ -0,0 +1,330 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/currency"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// defaultAlertThreshold is the share of a budget, in percent, spent before the
+// owner is warned when the budget doesn't say otherwise.
+const defaultAlertThreshold = 80
+
+// expenseCategories lists the categories in the order budgets report them.
+var expenseCategories = []spec.ExpenseCategory{
+	spec.ExpenseCategoryLodging,
+	spec.ExpenseCategoryFood,
+	spec.ExpenseCategoryTransport,
+	spec.ExpenseCategoryActivities,
+	spec.ExpenseCategoryOther,
+}
+
+// Get a trip budget with planned and actual spend per category.
+// (GET /trips/{tripId}/budget)
+func (api API) GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+	if !trip.HomeCurrency.Valid {
+		return api.problem(w, r, errHomeCurrencyRequired)
+	}
+	home := trip.HomeCurrency.String
+
+	budgets, err := api.store.GetTripBudgets(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip budgets", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	byCategory := make(map[string]pgstore.Budget, len(budgets))
+	for _, budget := range budgets {
+		byCategory[budget.Category] = budget
+	}
+
+	actual, planned, err := api.budgetSpend(r.Context(), trip)
+	if err != nil {
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			api.logger.Error("failed to add up trip spend", zap.Error(err), zap.String("trip_id", tripID))
+		}
+		return api.problem(w, r, err)
+	}
+
+	response := spec.GetBudgetResponse{
+		Currency:   home,
+		Categories: make([]spec.BudgetLine, 0, len(expenseCategories)),
+	}
+
+	var total, totalPlanned, totalActual int64
+	for _, category := range expenseCategories {
+		line := spec.BudgetLine{
+			Category: category,
+			Planned:  currency.Format(planned[category.ToValue()], home),
+			Actual:   currency.Format(actual[category.ToValue()], home),
+		}
+
+		if budget, ok := byCategory[category.ToValue()]; ok {
+			total += budget.Amount
+			line.Budget = ptr(currency.Format(budget.Amount, home))
+			line.AlertThreshold = ptr(int(budget.AlertThreshold))
+			line.Remaining = ptr(currency.Format(budget.Amount-actual[category.ToValue()], home))
+		}
+
+		totalPlanned += planned[category.ToValue()]
+		totalActual += actual[category.ToValue()]
+		response.Categories = append(response.Categories, line)
+	}
+
+	response.Total = spec.BudgetTotals{
+		Planned: currency.Format(totalPlanned, home),
+		Actual:  currency.Format(totalActual, home),
+	}
+	if len(budgets) > 0 {
+		response.Total.Budget = ptr(currency.Format(total, home))
+		response.Total.Remaining = ptr(currency.Format(total-totalActual, home))
+	}
+
+	return spec.GetTripsTripIDBudgetJSON200Response(response)
+}
+
+// Set the budget of a category.
+// (PUT /trips/{tripId}/budgets/{category})
+func (api API) PutTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request, tripID string, category spec.PutTripsTripIDBudgetsCategoryParamsCategory) *spec.Response {
+	var body spec.BudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	var c spec.ExpenseCategory
+	if err := c.FromValue(string(category)); err != nil {
+		return api.problem(w, r, errNotFound("category_not_found", "category not found"))
+	}
+
+	trip := tripFromContext(r.Context())
+	if !trip.HomeCurrency.Valid {
+		return api.problem(w, r, errHomeCurrencyRequired)
+	}
+
+	amount, err := currency.Parse(body.Amount, trip.HomeCurrency.String)
+	if err != nil {
+		precision := strconv.Itoa(currency.Exponent(trip.HomeCurrency.String))
+		return api.problem(w, r, errInvalidField("amount", "currency_precision", "must have at most "+precision+" decimals in "+trip.HomeCurrency.String))
+	}
+
+	params := pgstore.UpsertBudgetParams{
+		TripID:         trip.ID,
+		Category:       c.ToValue(),
+		Amount:         amount,
+		Currency:       trip.HomeCurrency.String,
+		AlertThreshold: defaultAlertThreshold,
+	}
+	if body.AlertThreshold != nil {
+		params.AlertThreshold = int16(*body.AlertThreshold)
+	}
+
+	if err := api.store.UpsertBudget(r.Context(), params); err != nil {
+		// The budget references the home currency it was parsed in, which
+		// another request changed in the meantime.
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errConflict("home_currency_changed", "the home currency of the trip changed, try again"))
+		}
+
+		api.logger.Error("failed to upsert budget", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	// The new limit may already be past its threshold.
+	go api.checkBudget(trip.ID, params.Category)
+
+	return spec.PutTripsTripIDBudgetsCategoryJSON204Response(nil)
+}
+
+// Remove the budget of a category.
+// (DELETE /trips/{tripId}/budgets/{category})
+func (api API) DeleteTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request, tripID string, category spec.DeleteTripsTripIDBudgetsCategoryParamsCategory) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	deleted, err := api.store.DeleteBudget(r.Context(), pgstore.DeleteBudgetParams{TripID: id, Category: string(category)})
+	if err != nil {
+		api.logger.Error("failed to delete budget", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errNotFound("budget_not_found", "budget not found"))
+	}
+
+	return spec.DeleteTripsTripIDBudgetsCategoryJSON204Response(nil)
+}
+
+var errHomeCurrencyRequired = errConflict("home_currency_required", "set a home currency on the trip to plan a budget")
+
+// errHomeCurrencyHasBudgets is returned when changing the home currency of a
+// trip whose budgets are amounts in it.
+var errHomeCurrencyHasBudgets = errConflict("home_currency_has_budgets", "remove the budgets of the trip before changing its home currency")
+
+// budgetSpend adds up per category, in the trip's home currency, the
+// expenses logged so far and the estimated cost of the scheduled activities
+// and of the reservations. A missing exchange rate comes back as an *Error
+// that can be written as is.
+func (api API) budgetSpend(ctx context.Context, trip pgstore.Trip) (actual, planned map[string]int64, err error) {
+	home := trip.HomeCurrency.String
+	tables := make(map[time.Time]currency.Table)
+
+	expenses, err := api.store.GetTripExpenses(ctx, trip.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actual = make(map[string]int64)
+	for _, expense := range expenses {
+		if !expense.ID.Valid {
+			continue
+		}
+
+		amount, err := api.convert(ctx, tables, expense.Amount.Int64, expense.Currency.String, home, expense.SpentAt.Time)
+		if err != nil {
+			return nil, nil, err
+		}
+		actual[expense.Category.String] += amount
+	}
+
+	activities, err := api.store.GetTripActivities(ctx, trip.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	planned = make(map[string]int64)
+	for _, activity := range activities {
+		if !activity.ID.Valid || !activity.EstimatedCost.Valid {
+			continue
+		}
+
+		amount, err := api.convert(ctx, tables, activity.EstimatedCost.Int64, activity.EstimatedCurrency.String, home, activity.OccursAt.Time)
+		if err != nil {
+			return nil, nil, err
+		}
+		planned[spec.ExpenseCategoryActivities.ToValue()] += amount
+	}
+
+	reservations, err := api.store.GetTripReservations(ctx, trip.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, reservation := range reservations {
+		if !reservation.Cost.Valid {
+			continue
+		}
+
+		amount, err := api.convert(ctx, tables, reservation.Cost.Int64, reservation.CostCurrency.String, home, reservation.StartsAt.Time)
+		if err != nil {
+			return nil, nil, err
+		}
+		planned[reservationCategory(reservation.Kind)] += amount
+	}
+
+	return actual, planned, nil
+}
+
+// checkBudget emails the owner the first time the spend of a category reaches
+// its alert threshold. It runs once the request has been answered, so failures
+// are only logged.
+func (api API) checkBudget(tripID uuid.UUID, category string) {
+	ctx := context.Background()
+	budget, err := api.store.GetBudget(ctx, pgstore.GetBudgetParams{TripID: tripID, Category: category})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			api.logger.Error("failed to get budget", zap.Error(err), zap.String("trip_id", tripID.String()))
+		}
+		return
+	}
+
+	if budget.AlertedAt.Valid {
+		return
+	}
+
+	trip, err := api.store.GetTrip(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	if !trip.HomeCurrency.Valid {
+		return
+	}
+
+	actual, _, err := api.budgetSpend(ctx, trip)
+	if err != nil {
+		api.logger.Warn("failed to add up trip spend for budget alert", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	spent := actual[category]
+	if !pastThreshold(spent, budget.Amount, budget.AlertThreshold) {
+		return
+	}
+
+	// Only the request that flips alerted_at sends the email, so concurrent
+	// expenses don't warn the owner twice.
+	claimed, err := api.store.MarkBudgetAlerted(ctx, pgstore.MarkBudgetAlertedParams{TripID: tripID, Category: category})
+	if err != nil {
+		api.logger.Error("failed to mark budget alerted", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	if claimed == 0 {
+		return
+	}
+
+	home := trip.HomeCurrency.String
+	alert := mailpit.BudgetAlert{
+		Category:  category,
+		Spent:     currency.Format(spent, home) + " " + home,
+		Budget:    currency.Format(budget.Amount, home) + " " + home,
+		Threshold: int(budget.AlertThreshold),
+	}
+	if err := api.mailer.SendBudgetAlertEmailToTripOwner(alert, tripID); err != nil {
+		api.logger.Error(
+			"failed to send budget alert email",
+			zap.Error(err),
+			zap.String("trip_id", tripID.String()),
+			zap.String("category", category),
+		)
+	}
+}
+
+// reservationCategory is the budget category the cost of a reservation is
+// planned under.
+func reservationCategory(kind string) string {
+	if kind == spec.ReservationKindLodging.ToValue() {
+		return spec.ExpenseCategoryLodging.ToValue()
+	}
+	return spec.ExpenseCategoryTransport.ToValue()
+}
+
+// pastThreshold reports whether spent reaches threshold percent of amount. The
+// products are taken in big.Int since large amounts in minor units overflow
+// int64 once multiplied.
+func pastThreshold(spent, amount int64, threshold int16) bool {
+	used := new(big.Int).Mul(big.NewInt(spent), big.NewInt(100))
+	limit := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(threshold)))
+	return used.Cmp(limit) >= 0
+}
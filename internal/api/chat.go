@@ -0,0 +1,446 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/chat"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	chatChannelBatch = 20
+	// chatEventsBatch is how many events of a trip a channel posts in one go,
+	// one after the other.
+	chatEventsBatch = 20
+	// chatLease is how long a claimed channel is left alone before it is
+	// claimed again. It must outlast posting chatEventsBatch messages.
+	chatLease          = 5 * time.Minute
+	chatFirstRetry     = 30 * time.Second
+	chatMaxAttempts    = 8
+	chatActivityLayout = "Mon, Jan 2 15:04"
+)
+
+var errChatChannelNotFound = errNotFound("chat_channel_not_found", "chat channel not found")
+
+// Add a chat channel for the events of a trip.
+// (POST /trips/{tripId}/chat-channels)
+func (api API) PostTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.ChatChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	if _, ok := api.notifiers[body.Platform.ToValue()]; !ok {
+		return api.problem(w, r, errInvalidField("platform", "required", "must be slack or discord"))
+	}
+
+	if err := checkPublicURL(r.Context(), "url", body.URL); err != nil {
+		return api.problem(w, r, err)
+	}
+
+	trip := tripFromContext(r.Context())
+
+	params := pgstore.CreateChatChannelParams{
+		TripID:   trip.ID,
+		Name:     body.Name,
+		Platform: body.Platform.ToValue(),
+		Url:      body.URL,
+		Events:   make([]string, 0, len(body.Events)),
+	}
+	for _, event := range body.Events {
+		params.Events = append(params.Events, event.ToValue())
+	}
+
+	channelID, err := api.store.CreateChatChannel(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to create chat channel", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDChatChannelsJSON201Response(spec.CreateChatChannelResponse{ChannelID: channelID.String()})
+}
+
+// Get the chat channels of a trip.
+// (GET /trips/{tripId}/chat-channels)
+func (api API) GetTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	channels, err := api.store.GetTripChatChannels(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get chat channels", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetChatChannelsResponse{Channels: make([]spec.ChatChannel, 0, len(channels))}
+	for _, channel := range channels {
+		events := make([]spec.TripEventType, 0, len(channel.Events))
+		for _, event := range channel.Events {
+			events = append(events, tripEventType(event))
+		}
+
+		var platform spec.ChatPlatform
+		_ = platform.FromValue(channel.Platform)
+
+		response.Channels = append(response.Channels, spec.ChatChannel{
+			ID:        channel.ID.String(),
+			Name:      channel.Name,
+			Platform:  platform,
+			Events:    events,
+			Disabled:  channel.DisabledAt.Valid,
+			LastError: stringFromText(channel.LastError),
+			CreatedAt: channel.CreatedAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDChatChannelsJSON200Response(response)
+}
+
+// Delete a chat channel.
+// (DELETE /trips/{tripId}/chat-channels/{channelId})
+func (api API) DeleteTripsTripIDChatChannelsChannelID(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	id, err := uuid.Parse(channelID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	deleted, err := api.store.DeleteTripChatChannel(r.Context(), pgstore.DeleteTripChatChannelParams{ID: id, TripID: trip.ID})
+	if err != nil {
+		api.logger.Error("failed to delete chat channel", zap.Error(err), zap.String("channel_id", channelID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errChatChannelNotFound)
+	}
+
+	return spec.DeleteTripsTripIDChatChannelsChannelIDJSON204Response(nil)
+}
+
+// Post a test message to a chat channel.
+// (POST /trips/{tripId}/chat-channels/{channelId}/test)
+func (api API) PostTripsTripIDChatChannelsChannelIDTest(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	id, err := uuid.Parse(channelID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	channel, err := api.store.GetTripChatChannel(r.Context(), pgstore.GetTripChatChannelParams{ID: id, TripID: trip.ID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errChatChannelNotFound)
+		}
+
+		api.logger.Error("failed to get chat channel", zap.Error(err), zap.String("channel_id", channelID))
+		return api.problem(w, r, errInternal)
+	}
+
+	notifier, ok := api.notifiers[channel.Platform]
+	if !ok {
+		api.logger.Error("no notifier for chat platform", zap.String("platform", channel.Platform))
+		return api.problem(w, r, errInternal)
+	}
+
+	err = notifier.Notify(r.Context(), channel.Url, chat.Notification{
+		Trip:       chatTripName(trip.Destination),
+		Title:      "Test message",
+		Text:       "The events of this trip will be posted here.",
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		// The error holds what the platform answered, which stays in the logs
+		// so the endpoint can't be used to read what a URL serves.
+		api.logger.Warn("failed to post chat test message", zap.Error(err), zap.String("channel_id", channelID))
+		return api.problem(w, r, errBadGateway("chat_post_failed", chatTestFailure(err)))
+	}
+
+	return spec.PostTripsTripIDChatChannelsChannelIDTestJSON204Response(nil)
+}
+
+// chatTestFailure says why a test message didn't get through, without what
+// the platform answered.
+func chatTestFailure(err error) string {
+	var rateLimit *chat.RateLimitError
+	switch {
+	case errors.Is(err, chat.ErrGone):
+		return "the chat platform no longer accepts messages on this webhook"
+	case errors.Is(err, chat.ErrRejected):
+		return "the chat platform refused the test message"
+	case errors.As(err, &rateLimit):
+		return "the chat platform is limiting the rate, try again later"
+	default:
+		return "failed to post the test message"
+	}
+}
+
+// PostChatNotifications posts the trip events waiting for the chat channels
+// that are due and reports how many channels it got to. Channels are claimed
+// first, so instances running it side by side don't post the same event
+// twice.
+func (api API) PostChatNotifications(ctx context.Context) (int, error) {
+	channels, err := api.store.ClaimChatChannels(ctx, pgstore.ClaimChatChannelsParams{
+		MaxResults:   chatChannelBatch,
+		LeaseSeconds: int32(chatLease / time.Second),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, channel := range channels {
+		wg.Add(1)
+		go func(channel pgstore.ClaimChatChannelsRow) {
+			defer wg.Done()
+			api.postChatNotifications(ctx, channel)
+		}(channel)
+	}
+	wg.Wait()
+
+	return len(channels), nil
+}
+
+// postChatNotifications posts the next events of a trip to a channel in order,
+// stopping at the first that fails, and saves how far it got.
+func (api API) postChatNotifications(ctx context.Context, channel pgstore.ClaimChatChannelsRow) {
+	logger := api.logger.With(zap.String("channel_id", channel.ID.String()), zap.String("platform", channel.Platform))
+
+	events, err := api.store.GetTripEvents(ctx, pgstore.GetTripEventsParams{
+		TripID:      channel.TripID,
+		AfterXactID: channel.LastEventXactID,
+		AfterID:     channel.LastEventID,
+		MaxResults:  chatEventsBatch,
+	})
+	if err != nil {
+		// The lease running out gets the channel claimed again.
+		if ctx.Err() == nil {
+			logger.Error("failed to get trip events", zap.Error(err))
+		}
+		return
+	}
+
+	progress := pgstore.SaveChatChannelProgressParams{
+		ID:              channel.ID,
+		LastEventXactID: channel.LastEventXactID,
+		LastEventID:     channel.LastEventID,
+		Failures:        channel.Failures,
+	}
+
+	notifier, ok := api.notifiers[channel.Platform]
+	if !ok {
+		logger.Error("no notifier for chat platform")
+		return
+	}
+
+	for _, event := range events {
+		if len(channel.Events) > 0 && !slices.Contains(channel.Events, event.Type) {
+			progress.LastEventXactID, progress.LastEventID = event.XactID, event.ID
+			continue
+		}
+
+		notification, ok, err := api.chatNotification(ctx, channel.Destination, event)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("failed to build chat notification", zap.Error(err), zap.Int64("event_id", event.ID))
+			}
+			break
+		}
+
+		if ok {
+			err := notifier.Notify(ctx, channel.Url, notification)
+
+			// A post cut short by shutting down isn't the platform's fault,
+			// the lease running out lets it be made again.
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil && !errors.Is(err, chat.ErrRejected) {
+				api.chatNotificationFailed(ctx, logger, progress, event, err)
+				return
+			}
+
+			// Messages the platform refuses would be refused again.
+			if err != nil {
+				logger.Warn("chat platform rejected notification", zap.Error(err), zap.Int64("event_id", event.ID))
+			}
+		}
+
+		progress.LastEventXactID, progress.LastEventID = event.XactID, event.ID
+		progress.Failures = 0
+	}
+
+	if err := api.store.SaveChatChannelProgress(ctx, progress); err != nil {
+		logger.Error("failed to save chat channel progress", zap.Error(err))
+	}
+}
+
+// chatNotificationFailed saves the progress of a channel up to the event it
+// failed to post, and when to try again depending on what went wrong: the
+// channel is disabled once its webhook is gone, waits as long as it is told
+// when rate limited, and backs off otherwise until the event is passed over.
+func (api API) chatNotificationFailed(ctx context.Context, logger *zap.Logger, progress pgstore.SaveChatChannelProgressParams, event pgstore.TripEvent, err error) {
+	progress.LastError = pgtype.Text{String: err.Error(), Valid: true}
+
+	var limited *chat.RateLimitError
+	switch {
+	case errors.Is(err, chat.ErrGone):
+		logger.Warn("disabled chat channel", zap.Error(err))
+		progress.Disable = true
+	case errors.As(err, &limited):
+		progress.RetryInSeconds = int32(limited.RetryAfter / time.Second)
+	case progress.Failures+1 >= chatMaxAttempts:
+		logger.Warn("gave up on chat notification", zap.Error(err), zap.Int64("event_id", event.ID))
+		progress.LastEventXactID, progress.LastEventID = event.XactID, event.ID
+		progress.Failures = 0
+	default:
+		progress.Failures++
+		progress.RetryInSeconds = int32((chatFirstRetry << (progress.Failures - 1)) / time.Second)
+	}
+
+	if err := api.store.SaveChatChannelProgress(ctx, progress); err != nil {
+		logger.Error("failed to save chat channel progress", zap.Error(err))
+	}
+}
+
+// chatNotification describes a trip event for a chat, looking up what it is
+// about when that still exists. It reports false for events not worth a
+// message, such as bookkeeping updates.
+func (api API) chatNotification(ctx context.Context, destination string, event pgstore.TripEvent) (chat.Notification, bool, error) {
+	n := chat.Notification{Trip: chatTripName(destination), OccurredAt: event.CreatedAt.Time}
+	changed := chatFields(event.Fields)
+
+	switch event.Type {
+	case "trip.updated":
+		switch {
+		case slices.Contains(event.Fields, "is_confirmed"):
+			n.Title = "Trip confirmed"
+		case len(changed) > 0:
+			n.Title = "Trip details changed"
+			n.Text = "Changed: " + strings.Join(changed, ", ")
+		default:
+			return chat.Notification{}, false, nil
+		}
+
+	case "activity.created", "activity.updated":
+		activity, err := api.store.GetActivity(ctx, pgstore.GetActivityParams{ID: event.SubjectID, TripID: event.TripID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return chat.Notification{}, false, nil
+			}
+			return chat.Notification{}, false, fmt.Errorf("failed to get activity: %w", err)
+		}
+
+		n.Text = activity.Title + " on " + activity.OccursAt.Time.Format(chatActivityLayout)
+		if event.Type == "activity.created" {
+			n.Title = "New activity"
+			break
+		}
+		if len(changed) == 0 {
+			return chat.Notification{}, false, nil
+		}
+		n.Title = "Activity changed"
+		n.Text += "\nChanged: " + strings.Join(changed, ", ")
+
+	case "activity.deleted":
+		n.Title = "Activity removed"
+
+	case "link.created", "link.updated":
+		link, err := api.store.GetTripLink(ctx, pgstore.GetTripLinkParams{ID: event.SubjectID, TripID: event.TripID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return chat.Notification{}, false, nil
+			}
+			return chat.Notification{}, false, fmt.Errorf("failed to get link: %w", err)
+		}
+
+		n.Title = "New link"
+		if event.Type == "link.updated" {
+			if len(changed) == 0 {
+				return chat.Notification{}, false, nil
+			}
+			n.Title = "Link changed"
+		}
+		n.Text = link.Title + "\n" + link.Url
+
+	case "link.deleted":
+		n.Title = "Link removed"
+
+	case "participant.created", "participant.updated":
+		participant, err := api.store.GetParticipant(ctx, event.SubjectID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return chat.Notification{}, false, nil
+			}
+			return chat.Notification{}, false, fmt.Errorf("failed to get participant: %w", err)
+		}
+
+		name := participantName(participant)
+		switch {
+		case event.Type == "participant.created":
+			n.Title = "Participant invited"
+			n.Text = name + " was invited to the trip"
+		case slices.Contains(event.Fields, "waitlisted_at") && participant.WaitlistedAt.Valid:
+			n.Title = "Participant waitlisted"
+			n.Text = name + " is on the waitlist"
+		case slices.Contains(event.Fields, "is_confirmed") && participant.IsConfirmed:
+			n.Title = "Participant confirmed"
+			n.Text = name + " is coming along"
+		case slices.Contains(event.Fields, "waitlisted_at") && !participant.WaitlistedAt.Valid && participant.IsConfirmed:
+			n.Title = "Participant off the waitlist"
+			n.Text = name + " is coming along"
+		case slices.Contains(event.Fields, "role"):
+			n.Title = "Participant role changed"
+			n.Text = name + " is now a trip " + participant.Role
+		default:
+			// Resent invites and the like.
+			return chat.Notification{}, false, nil
+		}
+
+	case "participant.deleted":
+		n.Title = "Participant left"
+		n.Text = "A participant is no longer on the trip"
+
+	default:
+		return chat.Notification{}, false, nil
+	}
+
+	return n, true, nil
+}
+
+func chatTripName(destination string) string {
+	return "Trip to " + destination
+}
+
+// chatFields turns the columns an update changed into words, leaving out the
+// bookkeeping ones.
+func chatFields(fields []string) []string {
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch field {
+		case "version", "invite_count", "last_invited_at":
+			continue
+		}
+		words = append(words, strings.ReplaceAll(field, "_", " "))
+	}
+	return words
+}
@@ -0,0 +1,527 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Create a checklist on a trip.
+// (POST /trips/{tripId}/checklists)
+func (api API) PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.ChecklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	trip := tripFromContext(r.Context())
+
+	participantID, ok := api.participantField(w, r, trip.ID, "participant_id", body.ParticipantID)
+	if !ok {
+		return nil
+	}
+
+	var items []string
+	if body.TemplateID != nil {
+		template, err := api.store.GetChecklistTemplate(r.Context(), pgstore.GetChecklistTemplateParams{
+			ID:         uuid.MustParse(*body.TemplateID),
+			OwnerEmail: trip.OwnerEmail,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return api.problem(w, r, errInvalidField("template_id", "checklist_template", "must be a template of the trip owner"))
+			}
+
+			api.logger.Error("failed to get checklist template", zap.Error(err), zap.String("trip_id", tripID))
+			return api.problem(w, r, errInternal)
+		}
+		items = template.Items
+	}
+
+	checklistID, err := api.store.CreateChecklistWithItems(r.Context(), api.pool, pgstore.CreateChecklistParams{
+		TripID:        trip.ID,
+		ParticipantID: participantID,
+		Title:         body.Title,
+	}, items)
+	if err != nil {
+		api.logger.Error("failed to create checklist", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDChecklistsJSON201Response(spec.CreateChecklistResponse{
+		ChecklistID: checklistID.String(),
+	})
+}
+
+// Get a trip checklists.
+// (GET /trips/{tripId}/checklists)
+func (api API) GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDChecklistsParams) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	var participantID uuid.UUID
+	if params.ParticipantID != nil {
+		id, err := uuid.Parse(*params.ParticipantID)
+		if err != nil {
+			return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		}
+		participantID = id
+	}
+
+	checklists, err := api.store.GetTripChecklists(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip checklists", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	items, err := api.store.GetTripChecklistItems(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip checklist items", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	byChecklist := make(map[uuid.UUID][]pgstore.ChecklistItem, len(checklists))
+	for _, item := range items {
+		byChecklist[item.ChecklistID] = append(byChecklist[item.ChecklistID], item)
+	}
+
+	response := spec.GetChecklistsResponse{Checklists: make([]spec.Checklist, 0, len(checklists))}
+	for _, checklist := range checklists {
+		personal := checklist.ParticipantID.Valid
+		if params.ParticipantID != nil && personal && uuid.UUID(checklist.ParticipantID.Bytes) != participantID {
+			continue
+		}
+		response.Checklists = append(response.Checklists, checklistResponse(checklist, byChecklist[checklist.ID]))
+	}
+
+	return spec.GetTripsTripIDChecklistsJSON200Response(response)
+}
+
+// Get a trip checklist.
+// (GET /trips/{tripId}/checklists/{checklistId})
+func (api API) GetTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return nil
+	}
+
+	items, err := api.store.GetChecklistItems(r.Context(), checklist.ID)
+	if err != nil {
+		api.logger.Error("failed to get checklist items", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.GetTripsTripIDChecklistsChecklistIDJSON200Response(spec.GetChecklistResponse{
+		Checklist: checklistResponse(checklist, items),
+	})
+}
+
+// Delete a trip checklist.
+// (DELETE /trips/{tripId}/checklists/{checklistId})
+func (api API) DeleteTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	cid, err := uuid.Parse(checklistID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	deleted, err := api.store.DeleteChecklist(r.Context(), pgstore.DeleteChecklistParams{ID: cid, TripID: tid})
+	if err != nil {
+		api.logger.Error("failed to delete checklist", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errNotFound("checklist_not_found", "checklist not found"))
+	}
+
+	return spec.DeleteTripsTripIDChecklistsChecklistIDJSON204Response(nil)
+}
+
+// Add an item to a checklist.
+// (POST /trips/{tripId}/checklists/{checklistId}/items)
+func (api API) PostTripsTripIDChecklistsChecklistIDItems(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	var body spec.ChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return nil
+	}
+
+	assigneeID, ok := api.participantField(w, r, checklist.TripID, "assignee_id", body.AssigneeID)
+	if !ok {
+		return nil
+	}
+
+	itemID, err := api.store.CreateChecklistItem(r.Context(), pgstore.CreateChecklistItemParams{
+		ChecklistID: checklist.ID,
+		Text:        body.Text,
+		AssigneeID:  assigneeID,
+	})
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("checklist_not_found", "checklist not found"))
+		}
+
+		api.logger.Error("failed to create checklist item", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDChecklistsChecklistIDItemsJSON201Response(spec.CreateChecklistItemResponse{
+		ItemID: itemID.String(),
+	})
+}
+
+// Reorder the items of a checklist.
+// (PUT /trips/{tripId}/checklists/{checklistId}/items/order)
+func (api API) PutTripsTripIDChecklistsChecklistIDItemsOrder(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	var body spec.ChecklistOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return nil
+	}
+
+	items, err := api.store.GetChecklistItems(r.Context(), checklist.ID)
+	if err != nil {
+		api.logger.Error("failed to get checklist items", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	errIncomplete := errInvalidField("item_ids", "checklist_items", "must list every item of the checklist once")
+	if len(body.ItemIds) != len(items) {
+		return api.problem(w, r, errIncomplete)
+	}
+
+	remaining := make(map[uuid.UUID]struct{}, len(items))
+	for _, item := range items {
+		remaining[item.ID] = struct{}{}
+	}
+
+	order := make([]uuid.UUID, 0, len(body.ItemIds))
+	for _, itemID := range body.ItemIds {
+		id := uuid.MustParse(itemID)
+		if _, ok := remaining[id]; !ok {
+			return api.problem(w, r, errIncomplete)
+		}
+		delete(remaining, id)
+		order = append(order, id)
+	}
+
+	// Items added in the meantime keep their place at the end, items removed
+	// are skipped.
+	if err := api.store.ReorderChecklistItems(r.Context(), pgstore.ReorderChecklistItemsParams{
+		ItemIds:     order,
+		ChecklistID: checklist.ID,
+	}); err != nil {
+		api.logger.Error("failed to reorder checklist items", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PutTripsTripIDChecklistsChecklistIDItemsOrderJSON204Response(nil)
+}
+
+// Update a checklist item.
+// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+func (api API) PutTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *spec.Response {
+	var body spec.ChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return nil
+	}
+
+	id, err := uuid.Parse(itemID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	assigneeID, ok := api.participantField(w, r, checklist.TripID, "assignee_id", body.AssigneeID)
+	if !ok {
+		return nil
+	}
+
+	updated, err := api.store.UpdateChecklistItem(r.Context(), pgstore.UpdateChecklistItemParams{
+		Text:        body.Text,
+		AssigneeID:  assigneeID,
+		ID:          id,
+		ChecklistID: checklist.ID,
+	})
+	if err != nil {
+		api.logger.Error("failed to update checklist item", zap.Error(err), zap.String("item_id", itemID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errChecklistItemNotFound)
+	}
+
+	return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(nil)
+}
+
+// Remove an item from a checklist.
+// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+func (api API) DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *spec.Response {
+	if !api.changeChecklistItem(w, r, tripID, checklistID, itemID, "delete", api.store.DeleteChecklistItem) {
+		return nil
+	}
+	return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(nil)
+}
+
+// Check off a checklist item.
+// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId}/check)
+func (api API) PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *spec.Response {
+	check := func(ctx context.Context, arg pgstore.DeleteChecklistItemParams) (int64, error) {
+		return api.store.CheckChecklistItem(ctx, pgstore.CheckChecklistItemParams(arg))
+	}
+	if !api.changeChecklistItem(w, r, tripID, checklistID, itemID, "check", check) {
+		return nil
+	}
+	return spec.PutTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response(nil)
+}
+
+// Uncheck a checklist item.
+// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId}/check)
+func (api API) DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *spec.Response {
+	uncheck := func(ctx context.Context, arg pgstore.DeleteChecklistItemParams) (int64, error) {
+		return api.store.UncheckChecklistItem(ctx, pgstore.UncheckChecklistItemParams(arg))
+	}
+	if !api.changeChecklistItem(w, r, tripID, checklistID, itemID, "uncheck", uncheck) {
+		return nil
+	}
+	return spec.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response(nil)
+}
+
+// Save a checklist as a template.
+// (POST /trips/{tripId}/checklists/{checklistId}/template)
+func (api API) PostTripsTripIDChecklistsChecklistIDTemplate(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	var body spec.ChecklistTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	trip := tripFromContext(r.Context())
+
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return nil
+	}
+
+	items, err := api.store.GetChecklistItems(r.Context(), checklist.ID)
+	if err != nil {
+		api.logger.Error("failed to get checklist items", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	params := pgstore.CreateChecklistTemplateParams{
+		OwnerEmail: trip.OwnerEmail,
+		Title:      checklist.Title,
+		Items:      make([]string, 0, len(items)),
+	}
+	if body.Title != nil {
+		params.Title = *body.Title
+	}
+	for _, item := range items {
+		params.Items = append(params.Items, item.Text)
+	}
+
+	templateID, err := api.store.CreateChecklistTemplate(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to create checklist template", zap.Error(err), zap.String("checklist_id", checklistID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDChecklistsChecklistIDTemplateJSON201Response(spec.CreateChecklistTemplateResponse{
+		TemplateID: templateID.String(),
+	})
+}
+
+// Get the checklist templates of a trip owner.
+// (GET /trips/{tripId}/checklists/templates)
+func (api API) GetTripsTripIDChecklistsTemplates(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	templates, err := api.store.GetChecklistTemplates(r.Context(), trip.OwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to get checklist templates", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetChecklistTemplatesResponse{Templates: make([]spec.ChecklistTemplate, 0, len(templates))}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, spec.ChecklistTemplate{
+			ID:    template.ID.String(),
+			Title: template.Title,
+			Items: template.Items,
+		})
+	}
+
+	return spec.GetTripsTripIDChecklistsTemplatesJSON200Response(response)
+}
+
+// Delete a checklist template of a trip owner.
+// (DELETE /trips/{tripId}/checklists/templates/{templateId})
+func (api API) DeleteTripsTripIDChecklistsTemplatesTemplateID(w http.ResponseWriter, r *http.Request, tripID string, templateID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	deleted, err := api.store.DeleteChecklistTemplate(r.Context(), pgstore.DeleteChecklistTemplateParams{ID: id, OwnerEmail: trip.OwnerEmail})
+	if err != nil {
+		api.logger.Error("failed to delete checklist template", zap.Error(err), zap.String("template_id", templateID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errNotFound("checklist_template_not_found", "checklist template not found"))
+	}
+
+	return spec.DeleteTripsTripIDChecklistsTemplatesTemplateIDJSON204Response(nil)
+}
+
+var errChecklistItemNotFound = errNotFound("checklist_item_not_found", "checklist item not found")
+
+// changeChecklistItem runs change, one of the item queries that only need the
+// item and its checklist, answering 404 when the item isn't on the checklist.
+// When it reports false the problem has already been written.
+func (api API) changeChecklistItem(w http.ResponseWriter, r *http.Request, tripID, checklistID, itemID, op string, change func(context.Context, pgstore.DeleteChecklistItemParams) (int64, error)) bool {
+	checklist, ok := api.getChecklist(w, r, tripID, checklistID)
+	if !ok {
+		return false
+	}
+
+	id, err := uuid.Parse(itemID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return false
+	}
+
+	changed, err := change(r.Context(), pgstore.DeleteChecklistItemParams{ID: id, ChecklistID: checklist.ID})
+	if err != nil {
+		api.logger.Error("failed to "+op+" checklist item", zap.Error(err), zap.String("item_id", itemID))
+		api.problem(w, r, errInternal)
+		return false
+	}
+
+	if changed == 0 {
+		api.problem(w, r, errChecklistItemNotFound)
+		return false
+	}
+
+	return true
+}
+
+// getChecklist loads a checklist of a trip. When it reports false the problem
+// has already been written.
+func (api API) getChecklist(w http.ResponseWriter, r *http.Request, tripID, checklistID string) (pgstore.Checklist, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Checklist{}, false
+	}
+
+	cid, err := uuid.Parse(checklistID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Checklist{}, false
+	}
+
+	checklist, err := api.store.GetChecklist(r.Context(), pgstore.GetChecklistParams{ID: cid, TripID: tid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errNotFound("checklist_not_found", "checklist not found"))
+			return pgstore.Checklist{}, false
+		}
+
+		api.logger.Error("failed to get checklist", zap.Error(err), zap.String("checklist_id", checklistID))
+		api.problem(w, r, errInternal)
+		return pgstore.Checklist{}, false
+	}
+
+	return checklist, true
+}
+
+// participantField checks that participantID, read from field of the request
+// body, is a participant of the trip. A nil participantID is valid and comes
+// back as a NULL. When it reports false the problem has already been written.
+func (api API) participantField(w http.ResponseWriter, r *http.Request, tripID uuid.UUID, field string, participantID *string) (pgtype.UUID, bool) {
+	if participantID == nil {
+		return pgtype.UUID{}, true
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), uuid.MustParse(*participantID))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", *participantID))
+		api.problem(w, r, errInternal)
+		return pgtype.UUID{}, false
+	}
+
+	if err != nil || participant.TripID != tripID {
+		api.problem(w, r, errInvalidField(field, "trip_participant", "must be a participant of the trip"))
+		return pgtype.UUID{}, false
+	}
+
+	return pgtype.UUID{Bytes: participant.ID, Valid: true}, true
+}
+
+func checklistResponse(checklist pgstore.Checklist, items []pgstore.ChecklistItem) spec.Checklist {
+	response := spec.Checklist{
+		ID:            checklist.ID.String(),
+		Title:         checklist.Title,
+		ParticipantID: stringFromUUID(checklist.ParticipantID),
+		Items:         make([]spec.ChecklistItem, 0, len(items)),
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, spec.ChecklistItem{
+			ID:         item.ID.String(),
+			Text:       item.Text,
+			AssigneeID: stringFromUUID(item.AssigneeID),
+			Checked:    item.CheckedAt.Valid,
+			CheckedAt:  timeFromTimestamp(item.CheckedAt),
+		})
+	}
+	return response
+}
@@ -0,0 +1,369 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/jackc/pgx/v5"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	// tripPresenceChannel is where Postgres announces the trips someone joined,
+	// left, locked or unlocked, see migration 024.
+	tripPresenceChannel = "trip_presence"
+
+	heartbeatInterval = 10 * time.Second
+	// sessionTimeout is how long a channel counts as open without heartbeats,
+	// e.g. once the instance serving it went away.
+	sessionTimeout = 30 * time.Second
+	// staleSessionAge is when sessions left behind that way are cleaned up.
+	staleSessionAge = time.Hour
+	lockTTL         = 30 * time.Second
+	writeWait       = 10 * time.Second
+	maxMessageSize  = 4096
+)
+
+// collaborationRequest is a message sent by a client on the collaboration
+// channel.
+type collaborationRequest struct {
+	Type       string `json:"type"`
+	ActivityID string `json:"activity_id"`
+}
+
+type presenceMessage struct {
+	Type         string               `json:"type"`
+	Participants []presentParticipant `json:"participants"`
+}
+
+type presentParticipant struct {
+	ParticipantID string               `json:"participant_id"`
+	Name          string               `json:"name"`
+	Email         string               `json:"email"`
+	Role          spec.ParticipantRole `json:"role"`
+}
+
+type locksMessage struct {
+	Type  string         `json:"type"`
+	Locks []activityLock `json:"locks"`
+}
+
+type activityLock struct {
+	ActivityID    string    `json:"activity_id"`
+	ParticipantID string    `json:"participant_id"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+type collaborationError struct {
+	Type       string `json:"type"`
+	Code       string `json:"code"`
+	Detail     string `json:"detail"`
+	ActivityID string `json:"activity_id,omitempty"`
+}
+
+// Open the collaboration channel of a trip.
+// (GET /trips/{tripId}/collaboration)
+func (api API) GetTripsTripIDCollaboration(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDCollaborationParams) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	participantID, err := uuid.Parse(params.ParticipantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participant, ok := api.tripParticipant(w, r, trip.ID, participantID)
+	if !ok {
+		return nil
+	}
+
+	if !participant.IsConfirmed || participant.WaitlistedAt.Valid {
+		return api.problem(w, r, errForbidden("participant_not_confirmed", "only confirmed participants may open the collaboration channel"))
+	}
+
+	if err := api.store.DeleteStaleTripSessions(r.Context(), int32(staleSessionAge/time.Second)); err != nil {
+		api.logger.Error("failed to delete stale trip sessions", zap.Error(err))
+	}
+
+	sessionID, err := api.store.OpenTripSession(r.Context(), pgstore.OpenTripSessionParams{
+		TripID:        trip.ID,
+		ParticipantID: participant.ID,
+	})
+	if err != nil {
+		api.logger.Error("failed to open trip session", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	// Closing the session releases its locks.
+	defer func() {
+		if err := api.store.CloseTripSession(context.Background(), sessionID); err != nil {
+			api.logger.Error("failed to close trip session", zap.Error(err), zap.String("session_id", sessionID.String()))
+		}
+	}()
+
+	upgrader := websocket.Upgrader{
+		Error: func(w http.ResponseWriter, r *http.Request, status int, reason error) {
+			api.problem(w, r, &Error{Status: status, Code: "websocket_upgrade_failed", Detail: reason.Error()})
+		},
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	s := &collaborationSession{
+		api:           api,
+		conn:          conn,
+		id:            sessionID,
+		tripID:        trip.ID,
+		participantID: participant.ID,
+	}
+	s.run(r.Context())
+
+	return nil
+}
+
+// collaborationSession serves one collaboration channel. Only run writes to
+// the connection.
+type collaborationSession struct {
+	api           API
+	conn          *websocket.Conn
+	id            uuid.UUID
+	tripID        uuid.UUID
+	participantID uuid.UUID
+
+	// The presence and locks last sent, so only changes are sent.
+	presence []byte
+	locks    []byte
+}
+
+func (s *collaborationSession) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wake, unsubscribe := s.api.presence.subscribe(s.tripID)
+	defer unsubscribe()
+
+	s.conn.SetReadLimit(maxMessageSize)
+	_ = s.conn.SetReadDeadline(time.Now().Add(sessionTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(sessionTimeout))
+	})
+
+	requests := make(chan []byte)
+	go func() {
+		defer close(requests)
+		for {
+			_, message, err := s.conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			_ = s.conn.SetReadDeadline(time.Now().Add(sessionTimeout))
+
+			select {
+			case requests <- message:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		if err := s.sync(ctx); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-requests:
+			if !ok {
+				return
+			}
+			if err := s.handle(ctx, message); err != nil {
+				return
+			}
+		case <-wake:
+		case <-heartbeat.C:
+			touched, err := s.api.store.TouchTripSession(ctx, s.id)
+			if err != nil {
+				s.api.logger.Error("failed to touch trip session", zap.Error(err), zap.String("session_id", s.id.String()))
+				return
+			}
+
+			// The session goes along with the participant when they leave the
+			// trip.
+			if touched == 0 {
+				_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "no longer on the trip"), time.Now().Add(writeWait))
+				return
+			}
+
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// handle answers a message from the client. It only fails when the
+// connection does, refused messages are answered with an error message.
+func (s *collaborationSession) handle(ctx context.Context, message []byte) error {
+	var request collaborationRequest
+	if err := json.Unmarshal(message, &request); err != nil {
+		return s.refuse("invalid_message", "messages must be JSON objects with a type", "")
+	}
+
+	switch request.Type {
+	case "lock":
+		return s.lock(ctx, request.ActivityID)
+	case "unlock":
+		activityID, err := uuid.Parse(request.ActivityID)
+		if err != nil {
+			return s.refuse("invalid_uuid", "activity_id must be a UUID", request.ActivityID)
+		}
+
+		if err := s.api.store.ReleaseActivityLock(ctx, pgstore.ReleaseActivityLockParams{ActivityID: activityID, SessionID: s.id}); err != nil {
+			s.api.logger.Error("failed to release activity lock", zap.Error(err), zap.String("activity_id", request.ActivityID))
+			return s.refuse(errInternal.Code, errInternal.Detail, request.ActivityID)
+		}
+		return nil
+	default:
+		return s.refuse("unknown_message_type", "type must be lock or unlock", "")
+	}
+}
+
+// lock takes or renews the lock on an activity for the session. The role is
+// looked up again, since it may have changed since the channel was opened.
+func (s *collaborationSession) lock(ctx context.Context, id string) error {
+	activityID, err := uuid.Parse(id)
+	if err != nil {
+		return s.refuse("invalid_uuid", "activity_id must be a UUID", id)
+	}
+
+	participant, err := s.api.store.GetParticipant(ctx, s.participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.refuse("participant_not_found", "participant not found", id)
+		}
+
+		s.api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", s.participantID.String()))
+		return s.refuse(errInternal.Code, errInternal.Detail, id)
+	}
+
+	if participant.Role != "editor" {
+		return s.refuse("not_trip_editor", "only editors may lock activities", id)
+	}
+
+	if _, err := s.api.store.GetActivity(ctx, pgstore.GetActivityParams{ID: activityID, TripID: s.tripID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return s.refuse("activity_not_found", "activity not found", id)
+		}
+
+		s.api.logger.Error("failed to get activity", zap.Error(err), zap.String("activity_id", id))
+		return s.refuse(errInternal.Code, errInternal.Detail, id)
+	}
+
+	claimed, err := s.api.store.ClaimActivityLock(ctx, pgstore.ClaimActivityLockParams{
+		ActivityID: activityID,
+		TripID:     s.tripID,
+		SessionID:  s.id,
+		TtlSeconds: int32(lockTTL / time.Second),
+	})
+	if err != nil {
+		s.api.logger.Error("failed to claim activity lock", zap.Error(err), zap.String("activity_id", id))
+		return s.refuse(errInternal.Code, errInternal.Detail, id)
+	}
+
+	if claimed == 0 {
+		return s.refuse("activity_locked", "the activity is being edited by someone else", id)
+	}
+
+	return nil
+}
+
+// sync sends the presence and the locks of the trip when they changed since
+// they were last sent. Locks that expired drop out here, on the next
+// heartbeat.
+func (s *collaborationSession) sync(ctx context.Context) error {
+	present, err := s.api.store.GetTripPresence(ctx, pgstore.GetTripPresenceParams{
+		TripID:         s.tripID,
+		TimeoutSeconds: int32(sessionTimeout / time.Second),
+	})
+	if err != nil {
+		s.api.logger.Error("failed to get trip presence", zap.Error(err), zap.String("trip_id", s.tripID.String()))
+		return nil
+	}
+
+	locks, err := s.api.store.GetActivityLocks(ctx, s.tripID)
+	if err != nil {
+		s.api.logger.Error("failed to get activity locks", zap.Error(err), zap.String("trip_id", s.tripID.String()))
+		return nil
+	}
+
+	presence := presenceMessage{Type: "presence", Participants: make([]presentParticipant, 0, len(present))}
+	for _, p := range present {
+		presence.Participants = append(presence.Participants, presentParticipant{
+			ParticipantID: p.ID.String(),
+			Name:          participantName(pgstore.Participant{Email: p.Email, Name: p.Name}),
+			Email:         p.Email,
+			Role:          participantRole(p.Role),
+		})
+	}
+
+	held := locksMessage{Type: "locks", Locks: make([]activityLock, 0, len(locks))}
+	for _, l := range locks {
+		held.Locks = append(held.Locks, activityLock{
+			ActivityID:    l.ActivityID.String(),
+			ParticipantID: l.ParticipantID.String(),
+			ExpiresAt:     l.ExpiresAt.Time,
+		})
+	}
+
+	if err := s.send(&s.presence, presence); err != nil {
+		return err
+	}
+	return s.send(&s.locks, held)
+}
+
+// send writes message unless it is the same as last, which it then replaces.
+func (s *collaborationSession) send(last *[]byte, message any) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(data, *last) {
+		return nil
+	}
+
+	if err := s.write(data); err != nil {
+		return err
+	}
+	*last = data
+	return nil
+}
+
+func (s *collaborationSession) refuse(code, detail, activityID string) error {
+	data, err := json.Marshal(collaborationError{Type: "error", Code: code, Detail: detail, ActivityID: activityID})
+	if err != nil {
+		return err
+	}
+	return s.write(data)
+}
+
+func (s *collaborationSession) write(data []byte) error {
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
@@ -0,0 +1,473 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCommentPage = 20
+	maxCommentPage     = 100
+)
+
+// mentionPattern matches an @ followed by an email, the way participants are
+// mentioned in comments.
+var mentionPattern = regexp.MustCompile(`@([^\s@]+@[^\s@]+\.[^\s@]+)`)
+
+// Comment on a trip, one of its activities or links.
+// (POST /trips/{tripId}/comments)
+func (api API) PostTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	trip := tripFromContext(r.Context())
+
+	authorID, ok := api.participantField(w, r, trip.ID, "author_id", &body.AuthorID)
+	if !ok {
+		return nil
+	}
+
+	params := pgstore.CreateCommentParams{
+		TripID:   trip.ID,
+		AuthorID: authorID,
+		Body:     body.Body,
+	}
+
+	if body.ParentID != nil {
+		parent, err := api.store.GetComment(r.Context(), pgstore.GetCommentParams{ID: uuid.MustParse(*body.ParentID), TripID: trip.ID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return api.problem(w, r, errInvalidField("parent_id", "trip_comment", "must be a comment of the trip"))
+			}
+
+			api.logger.Error("failed to get comment", zap.Error(err), zap.String("comment_id", *body.ParentID))
+			return api.problem(w, r, errInternal)
+		}
+
+		if parent.DeletedAt.Valid {
+			return api.problem(w, r, errInvalidField("parent_id", "trip_comment", "must be a comment that isn't deleted"))
+		}
+
+		if !sameTarget(parent.ActivityID, body.ActivityID) || !sameTarget(parent.LinkID, body.LinkID) {
+			return api.problem(w, r, errInvalidField("parent_id", "comment_target", "must be about the same trip, activity or link as the reply"))
+		}
+
+		params.ParentID = pgtype.UUID{Bytes: parent.ID, Valid: true}
+		params.ActivityID = parent.ActivityID
+		params.LinkID = parent.LinkID
+	} else {
+		params.ActivityID, params.LinkID, ok = api.commentTarget(w, r, trip.ID, body.ActivityID, body.LinkID)
+		if !ok {
+			return nil
+		}
+	}
+
+	commentID, err := api.store.CreateComment(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to create comment", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	go api.notifyMentions(trip.ID, authorID.Bytes, "", body.Body)
+
+	return spec.PostTripsTripIDCommentsJSON201Response(spec.CreateCommentResponse{
+		CommentID: commentID.String(),
+	})
+}
+
+// Get a page of comments.
+// (GET /trips/{tripId}/comments)
+func (api API) GetTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDCommentsParams) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	query := pgstore.GetCommentsParams{
+		TripID:         trip.ID,
+		AfterCreatedAt: pgtype.Timestamp{Valid: true},
+		MaxResults:     defaultCommentPage + 1,
+	}
+
+	for _, filter := range []struct {
+		value  *string
+		target *pgtype.UUID
+	}{
+		{params.ActivityID, &query.ActivityID},
+		{params.LinkID, &query.LinkID},
+		{params.ParentID, &query.ParentID},
+	} {
+		if filter.value == nil {
+			continue
+		}
+		id, err := uuid.Parse(*filter.value)
+		if err != nil {
+			return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		}
+		*filter.target = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxCommentPage {
+			return api.problem(w, r, errBadRequest("invalid_limit", "limit must be between 1 and "+strconv.Itoa(maxCommentPage)))
+		}
+		query.MaxResults = int32(*params.Limit) + 1
+	}
+
+	if params.Cursor != nil {
+		createdAt, id, err := decodeCommentCursor(*params.Cursor)
+		if err != nil {
+			return api.problem(w, r, errBadRequest("invalid_cursor", "invalid cursor"))
+		}
+		query.AfterCreatedAt = pgtype.Timestamp{Time: createdAt, Valid: true}
+		query.AfterID = id
+	}
+
+	// One comment more than the page holds tells whether there is a next page.
+	comments, err := api.store.GetComments(r.Context(), query)
+	if err != nil {
+		api.logger.Error("failed to get comments", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+	emails := participantEmails(participants)
+
+	var response spec.GetCommentsResponse
+	if n := int(query.MaxResults) - 1; len(comments) > n {
+		comments = comments[:n]
+		last := comments[n-1]
+		response.NextCursor = ptr(encodeCommentCursor(last.CreatedAt.Time, last.ID))
+	}
+
+	response.Comments = make([]spec.Comment, 0, len(comments))
+	for _, comment := range comments {
+		c := spec.Comment{
+			ID:         comment.ID.String(),
+			AuthorID:   stringFromUUID(comment.AuthorID),
+			ActivityID: stringFromUUID(comment.ActivityID),
+			LinkID:     stringFromUUID(comment.LinkID),
+			ParentID:   stringFromUUID(comment.ParentID),
+			Mentions:   []string{},
+			ReplyCount: int(comment.ReplyCount),
+			CreatedAt:  comment.CreatedAt.Time,
+			EditedAt:   timeFromTimestamp(comment.EditedAt),
+			Deleted:    comment.DeletedAt.Valid,
+		}
+		if !c.Deleted {
+			c.Body = ptr(comment.Body)
+			for _, id := range mentions(comment.Body, emails) {
+				c.Mentions = append(c.Mentions, id.String())
+			}
+		}
+		response.Comments = append(response.Comments, c)
+	}
+
+	return spec.GetTripsTripIDCommentsJSON200Response(response)
+}
+
+// Edit a comment.
+// (PUT /trips/{tripId}/comments/{commentId})
+func (api API) PutTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, commentID string, params spec.PutTripsTripIDCommentsCommentIDParams) *spec.Response {
+	var body spec.UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	comment, ok := api.authoredComment(w, r, tripID, commentID, params.XParticipantID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, comment.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	updated, err := api.store.UpdateComment(r.Context(), pgstore.UpdateCommentParams{
+		Body:    body.Body,
+		ID:      comment.ID,
+		TripID:  comment.TripID,
+		Version: comment.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to update comment", zap.Error(err), zap.String("comment_id", commentID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	go api.notifyMentions(comment.TripID, comment.AuthorID.Bytes, comment.Body, body.Body)
+
+	w.Header().Set("ETag", versionETag(comment.Version+1))
+	return spec.PutTripsTripIDCommentsCommentIDJSON204Response(nil)
+}
+
+// Delete a comment.
+// (DELETE /trips/{tripId}/comments/{commentId})
+func (api API) DeleteTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, commentID string, params spec.DeleteTripsTripIDCommentsCommentIDParams) *spec.Response {
+	comment, ok := api.authoredComment(w, r, tripID, commentID, params.XParticipantID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, comment.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	deleted, err := api.store.DeleteComment(r.Context(), pgstore.DeleteCommentParams{
+		ID:      comment.ID,
+		TripID:  comment.TripID,
+		Version: comment.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete comment", zap.Error(err), zap.String("comment_id", commentID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	return spec.DeleteTripsTripIDCommentsCommentIDJSON204Response(nil)
+}
+
+var errCommentNotFound = errNotFound("comment_not_found", "comment not found")
+
+// authoredComment loads a comment that participantID, as sent in
+// X-Participant-ID, may change: one they wrote that isn't deleted yet. When it
+// reports false the problem has already been written.
+func (api API) authoredComment(w http.ResponseWriter, r *http.Request, tripID, commentID, participantID string) (pgstore.Comment, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Comment{}, false
+	}
+
+	cid, err := uuid.Parse(commentID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Comment{}, false
+	}
+
+	pid, err := uuid.Parse(participantID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Comment{}, false
+	}
+
+	comment, err := api.store.GetComment(r.Context(), pgstore.GetCommentParams{ID: cid, TripID: tid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errCommentNotFound)
+			return pgstore.Comment{}, false
+		}
+
+		api.logger.Error("failed to get comment", zap.Error(err), zap.String("comment_id", commentID))
+		api.problem(w, r, errInternal)
+		return pgstore.Comment{}, false
+	}
+
+	if comment.DeletedAt.Valid {
+		api.problem(w, r, errCommentNotFound)
+		return pgstore.Comment{}, false
+	}
+
+	if !comment.AuthorID.Valid || uuid.UUID(comment.AuthorID.Bytes) != pid {
+		api.problem(w, r, errForbidden("not_comment_author", "only the author of a comment may change it"))
+		return pgstore.Comment{}, false
+	}
+
+	return comment, true
+}
+
+// commentTarget resolves the activity or link a new comment is about,
+// checking that it belongs to the trip. When it reports false the problem has
+// already been written.
+func (api API) commentTarget(w http.ResponseWriter, r *http.Request, tripID uuid.UUID, activityID, linkID *string) (pgtype.UUID, pgtype.UUID, bool) {
+	if activityID != nil {
+		activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: uuid.MustParse(*activityID), TripID: tripID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				api.problem(w, r, errInvalidField("activity_id", "trip_activity", "must be an activity of the trip"))
+				return pgtype.UUID{}, pgtype.UUID{}, false
+			}
+
+			api.logger.Error("failed to get activity", zap.Error(err), zap.String("activity_id", *activityID))
+			api.problem(w, r, errInternal)
+			return pgtype.UUID{}, pgtype.UUID{}, false
+		}
+		return pgtype.UUID{Bytes: activity.ID, Valid: true}, pgtype.UUID{}, true
+	}
+
+	if linkID != nil {
+		link, err := api.store.GetTripLink(r.Context(), pgstore.GetTripLinkParams{ID: uuid.MustParse(*linkID), TripID: tripID})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				api.problem(w, r, errInvalidField("link_id", "trip_link", "must be a link of the trip"))
+				return pgtype.UUID{}, pgtype.UUID{}, false
+			}
+
+			api.logger.Error("failed to get link", zap.Error(err), zap.String("link_id", *linkID))
+			api.problem(w, r, errInternal)
+			return pgtype.UUID{}, pgtype.UUID{}, false
+		}
+		return pgtype.UUID{}, pgtype.UUID{Bytes: link.ID, Valid: true}, true
+	}
+
+	return pgtype.UUID{}, pgtype.UUID{}, true
+}
+
+// notifyMentions emails the participants mentioned in body that weren't
+// already mentioned in previous, the body before an edit. It runs once the
+// request has been answered, so failures are only logged.
+func (api API) notifyMentions(tripID, authorID uuid.UUID, previous, body string) {
+	ctx := context.Background()
+	participants, err := api.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	emails := participantEmails(participants)
+	notified := make(map[uuid.UUID]struct{})
+	for _, id := range mentions(previous, emails) {
+		notified[id] = struct{}{}
+	}
+
+	author := ""
+	for _, participant := range participants {
+		if participant.ID.Valid && uuid.UUID(participant.ID.Bytes) == authorID {
+			author = participantName(pgstore.Participant{Email: participant.Email.String, Name: participant.Name})
+		}
+	}
+
+	for _, participant := range participants {
+		if !participant.ID.Valid {
+			continue
+		}
+
+		id := uuid.UUID(participant.ID.Bytes)
+		if _, ok := notified[id]; ok || id == authorID {
+			continue
+		}
+		if !containsID(mentions(body, emails), id) {
+			continue
+		}
+
+		recipient := pgstore.Participant{Email: participant.Email.String, Name: participant.Name}
+		if err := api.mailer.SendCommentMentionEmail(mailpit.ParticipantToSendEmail{
+			Name:  participantName(recipient),
+			Email: recipient.Email,
+		}, mailpit.CommentMention{Author: author, Body: body}, tripID); err != nil {
+			api.logger.Error(
+				"failed to send mention email",
+				zap.Error(err),
+				zap.String("trip_id", tripID.String()),
+				zap.String("participant_id", id.String()),
+			)
+		}
+	}
+}
+
+// participantEmails maps the lowercased email of every participant of a trip
+// to their ID.
+func participantEmails(participants []pgstore.GetParticipantsRow) map[string]uuid.UUID {
+	emails := make(map[string]uuid.UUID, len(participants))
+	for _, participant := range participants {
+		if participant.ID.Valid {
+			emails[strings.ToLower(participant.Email.String)] = participant.ID.Bytes
+		}
+	}
+	return emails
+}
+
+// mentions lists, in order and without repeats, the participants mentioned in
+// body. Mentions of emails that aren't on the trip are ignored.
+func mentions(body string, emails map[string]uuid.UUID) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		email := strings.ToLower(strings.TrimRight(match[1], ".,;:!?)"))
+		id, ok := emails[email]
+		if !ok || containsID(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sameTarget reports whether a reply naming requested, if anything, is about
+// the same activity or link as its parent.
+func sameTarget(parent pgtype.UUID, requested *string) bool {
+	if requested == nil {
+		return true
+	}
+	return parent.Valid && uuid.UUID(parent.Bytes).String() == *requested
+}
+
+// encodeCommentCursor and decodeCommentCursor turn the position of the last
+// comment of a page into an opaque cursor and back.
+func encodeCommentCursor(createdAt time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(createdAt.UnixNano(), 10) + ":" + id.String()))
+}
+
+func decodeCommentCursor(cursor string) (time.Time, uuid.UUID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	nanos, id, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return time.Time{}, uuid.Nil, errors.New("api: malformed comment cursor")
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	return time.Unix(0, n).UTC(), parsed, nil
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/currency"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	maxExchangeRateBytes = 1 << 20
+	maxExchangeRateRows  = 10000
+
+	// uploadedRateSource marks the rates admins uploaded, as opposed to the
+	// ones a provider filled in.
+	uploadedRateSource = "upload"
+)
+
+// Get the exchange rates in effect on a day.
+// (GET /exchange-rates)
+func (api API) GetExchangeRates(w http.ResponseWriter, r *http.Request, params spec.GetExchangeRatesParams) *spec.Response {
+	on := time.Now().UTC().Truncate(24 * time.Hour)
+	if params.On != nil {
+		on = params.On.Time
+	}
+
+	rates, err := api.store.GetExchangeRatesOn(r.Context(), pgtype.Date{Time: on, Valid: true})
+	if err != nil {
+		api.logger.Error("failed to get exchange rates", zap.Error(err), zap.Time("on", on))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetExchangeRatesResponse{
+		On:    types.Date{Time: on},
+		Rates: make([]spec.ExchangeRate, 0, len(rates)),
+	}
+	for _, rate := range rates {
+		response.Rates = append(response.Rates, spec.ExchangeRate{
+			Base:        rate.Base,
+			Quote:       rate.Quote,
+			Rate:        currency.FormatRate(ratFromNumeric(rate.Rate)),
+			EffectiveOn: types.Date{Time: rate.EffectiveOn.Time},
+			Source:      rate.Source,
+		})
+	}
+
+	return spec.GetExchangeRatesJSON200Response(response)
+}
+
+// Upload exchange rates.
+// (PUT /exchange-rates)
+func (api API) PutExchangeRates(w http.ResponseWriter, r *http.Request) *spec.Response {
+	rates, err := api.readExchangeRates(http.MaxBytesReader(w, r.Body, maxExchangeRateBytes), r.Header.Get("Content-Type"))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return api.problem(w, r, &Error{
+				Status: http.StatusRequestEntityTooLarge,
+				Code:   "payload_too_large",
+				Detail: "upload must be at most 1MB",
+			})
+		}
+
+		return api.problem(w, r, err)
+	}
+
+	if len(rates) == 0 || len(rates) > maxExchangeRateRows {
+		return api.problem(w, r, &Error{
+			Status: http.StatusUnprocessableEntity,
+			Code:   "invalid_row_count",
+			Detail: fmt.Sprintf("upload must have between 1 and %d rates", maxExchangeRateRows),
+		})
+	}
+
+	if err := api.saveExchangeRates(r.Context(), rates, uploadedRateSource); err != nil {
+		api.logger.Error("failed to save exchange rates", zap.Error(err))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PutExchangeRatesJSON200Response(spec.SaveExchangeRatesResponse{Saved: len(rates)})
+}
+
+// Load the exchange rates of the configured provider.
+// (POST /exchange-rates/refresh)
+func (api API) PostExchangeRatesRefresh(w http.ResponseWriter, r *http.Request) *spec.Response {
+	rates, err := api.rates.Rates(r.Context())
+	if err != nil {
+		api.logger.Error("failed to fetch exchange rates", zap.Error(err), zap.String("provider", api.rates.Name()))
+		return api.problem(w, r, errBadGateway("rate_provider_failed", "the exchange rate provider failed, try again later"))
+	}
+
+	if err := api.saveExchangeRates(r.Context(), rates, api.rates.Name()); err != nil {
+		api.logger.Error("failed to save exchange rates", zap.Error(err), zap.String("provider", api.rates.Name()))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostExchangeRatesRefreshJSON200Response(spec.SaveExchangeRatesResponse{Saved: len(rates)})
+}
+
+// RefreshExchangeRates stores the rates of the configured provider and
+// reports how many there were.
+func (api API) RefreshExchangeRates(ctx context.Context) (int, error) {
+	rates, err := api.rates.Rates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("api: failed to fetch exchange rates from %s: %w", api.rates.Name(), err)
+	}
+
+	if err := api.saveExchangeRates(ctx, rates, api.rates.Name()); err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
+
+func (api API) saveExchangeRates(ctx context.Context, rates []currency.Rate, source string) error {
+	params := make([]pgstore.UpsertExchangeRateParams, 0, len(rates))
+	for _, rate := range rates {
+		params = append(params, pgstore.UpsertExchangeRateParams{
+			Base:        rate.Base,
+			Quote:       rate.Quote,
+			Rate:        numericFromRat(rate.Rate),
+			EffectiveOn: pgtype.Date{Time: rate.EffectiveOn, Valid: true},
+			Source:      source,
+		})
+	}
+
+	return api.store.SaveExchangeRates(ctx, api.pool, params)
+}
+
+// readExchangeRates decodes an exchange rate upload, either a JSON object
+// listing the rates or CSV rows in the format of currency.ReadCSV.
+func (api API) readExchangeRates(body io.Reader, contentType string) ([]currency.Rate, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json":
+		var upload spec.ExchangeRatesUpload
+		if err := json.NewDecoder(body).Decode(&upload); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return nil, err
+			}
+			return nil, errBadRequest("invalid_json", "invalid json")
+		}
+
+		if err := api.validator.Struct(upload); err != nil {
+			return nil, errValidation(err)
+		}
+
+		rates := make([]currency.Rate, 0, len(upload.Rates))
+		for _, row := range upload.Rates {
+			rate, _ := currency.ParseRate(row.Rate)
+			rates = append(rates, currency.Rate{
+				Base:        row.Base,
+				Quote:       row.Quote,
+				Rate:        rate,
+				EffectiveOn: row.EffectiveOn.Time,
+			})
+		}
+		return rates, nil
+
+	case "text/csv":
+		rates, err := currency.ReadCSV(body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return nil, err
+			}
+			return nil, errBadRequest("invalid_csv", err.Error())
+		}
+		return rates, nil
+
+	default:
+		return nil, errUnsupportedMediaType("content type must be application/json or text/csv")
+	}
+}
+
+// exchangeRates loads the rates in effect on the day t falls on, reusing the
+// table of a day already looked up.
+func (api API) exchangeRates(ctx context.Context, tables map[time.Time]currency.Table, t time.Time) (currency.Table, error) {
+	day := t.Truncate(24 * time.Hour)
+	if table, ok := tables[day]; ok {
+		return table, nil
+	}
+
+	rows, err := api.store.GetExchangeRatesOn(ctx, pgtype.Date{Time: day, Valid: true})
+	if err != nil {
+		return currency.Table{}, err
+	}
+
+	rates := make([]currency.Rate, 0, len(rows))
+	for _, row := range rows {
+		rates = append(rates, currency.Rate{
+			Base:        row.Base,
+			Quote:       row.Quote,
+			Rate:        ratFromNumeric(row.Rate),
+			EffectiveOn: row.EffectiveOn.Time,
+		})
+	}
+
+	tables[day] = currency.NewTable(rates)
+	return tables[day], nil
+}
+
+// convert turns an amount of from into to at the rate in effect on the day at
+// falls on. A missing rate comes back as an *Error that can be written as is.
+func (api API) convert(ctx context.Context, tables map[time.Time]currency.Table, amount int64, from, to string, at time.Time) (int64, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	table, err := api.exchangeRates(ctx, tables, at)
+	if err != nil {
+		return 0, err
+	}
+
+	rate, ok := table.Lookup(from, to)
+	if !ok {
+		return 0, errConflict("exchange_rate_missing", fmt.Sprintf(
+			"no %s to %s exchange rate on %s, upload one to convert totals", from, to, at.Format(time.DateOnly),
+		))
+	}
+
+	converted, err := currency.Convert(amount, from, to, rate)
+	if err != nil {
+		return 0, errConflict("conversion_overflow", fmt.Sprintf(
+			"%s %s is too large to convert to %s", currency.Format(amount, from), from, to,
+		))
+	}
+
+	return converted, nil
+}
+
+func text(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+func stringFromText(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+	"go-plann.er/internal/api/spec"
+	"go.uber.org/zap"
+)
+
+const problemContentType = "application/problem+json"
+
+// Error is a failure that maps onto an HTTP status and a machine-readable
+// code. It is rendered to clients as RFC 7807 problem details.
+type Error struct {
+	Status int
+	Code   string
+	Detail string
+	Fields []spec.ProblemFieldError
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("api: %s: %s", e.Code, e.Detail)
+}
+
+var errInternal = &Error{
+	Status: http.StatusInternalServerError,
+	Code:   "internal_error",
+	Detail: "something went wrong, try again",
+}
+
+var errPreconditionFailed = &Error{
+	Status: http.StatusPreconditionFailed,
+	Code:   "precondition_failed",
+	Detail: "the resource was modified by someone else, fetch it again and retry",
+}
+
+func errBadRequest(code, detail string) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: code, Detail: detail}
+}
+
+func errNotFound(code, detail string) *Error {
+	return &Error{Status: http.StatusNotFound, Code: code, Detail: detail}
+}
+
+func errConflict(code, detail string) *Error {
+	return &Error{Status: http.StatusConflict, Code: code, Detail: detail}
+}
+
+func errForbidden(code, detail string) *Error {
+	return &Error{Status: http.StatusForbidden, Code: code, Detail: detail}
+}
+
+func errBadGateway(code, detail string) *Error {
+	return &Error{Status: http.StatusBadGateway, Code: code, Detail: detail}
+}
+
+func errUnsupportedMediaType(detail string) *Error {
+	return &Error{Status: http.StatusUnsupportedMediaType, Code: "unsupported_media_type", Detail: detail}
+}
+
+// errValidation converts the result of validator.Struct into a 422 with a
+// breakdown per invalid field.
+func errValidation(err error) *Error {
+	apiErr := &Error{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "validation_failed",
+		Detail: "invalid input",
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		apiErr.Detail = err.Error()
+		return apiErr
+	}
+
+	for _, fe := range fieldErrs {
+		field := fe.Namespace()
+		if _, rest, ok := strings.Cut(field, "."); ok {
+			field = rest
+		}
+
+		apiErr.Fields = append(apiErr.Fields, spec.ProblemFieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+
+	return apiErr
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email"
+	case "url":
+		return "must be a valid URL"
+	case "min":
+		switch fe.Kind() {
+		case reflect.String:
+			return "must be at least " + fe.Param() + " characters long"
+		case reflect.Slice:
+			return "must have at least " + fe.Param() + " items"
+		default:
+			return "must be at least " + fe.Param()
+		}
+	case "max":
+		switch fe.Kind() {
+		case reflect.String:
+			return "must be at most " + fe.Param() + " characters long"
+		case reflect.Slice:
+			return "must have at most " + fe.Param() + " items"
+		default:
+			return "must be at most " + fe.Param()
+		}
+	case "gtfield":
+		return "must be after " + snakeCase(fe.Param())
+	case "gtefield":
+		return "must not be before " + snakeCase(fe.Param())
+	case "contiguous":
+		return "must be the day the previous leg ends on"
+	case "timezone":
+		return "must be an IANA time zone such as Europe/Paris"
+	case "future":
+		return "must be in the future"
+	case "max_trip_length":
+		return "trip must not last longer than " + fe.Param() + " days"
+	case "unique_emails":
+		return "must not contain the same email twice"
+	case "no_self_invite":
+		return "must not contain the owner email"
+	case "unique":
+		return "must not contain the same " + snakeCase(fe.Param()) + " twice"
+	case "vote_or_rank":
+		return "must have a vote, a rank or both"
+	case "unique_ranks":
+		return "must not give the same rank twice"
+	case "uuid":
+		return "must be a valid UUID"
+	case "amount":
+		return "must be a positive amount"
+	case "currency":
+		return "must be an ISO 4217 currency code"
+	case "currency_precision":
+		return "must have at most " + fe.Param() + " decimals in this currency"
+	case "rate":
+		return "must be a positive decimal rate"
+	case "nefield":
+		return "must differ from " + snakeCase(fe.Param())
+	case "not_for_kind":
+		return "must be left out for " + strings.ReplaceAll(fe.Param(), "_", " ") + " reservations"
+	case "split_total":
+		return "amounts must add up to " + fe.Param()
+	case "urlscheme":
+		return "must use one of the schemes: " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "excluded_with":
+		return "must be left out when " + snakeCase(fe.Param()) + " is set"
+	default:
+		return "failed on the " + fe.Tag() + " rule"
+	}
+}
+
+// snakeCase turns a Go field name such as StartsAt or OptionID into its JSON
+// name.
+func snakeCase(name string) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			if unicode.IsLower(prev) {
+				b.WriteByte('_')
+			}
+			prev, r = r, unicode.ToLower(r)
+		} else {
+			prev = r
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// errInvalidField reports a field that is well-formed but doesn't fit the
+// stored data, e.g. a participant of another trip.
+func errInvalidField(field, rule, message string) *Error {
+	return &Error{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "validation_failed",
+		Detail: "invalid input",
+		Fields: []spec.ProblemFieldError{{Field: field, Rule: rule, Message: message}},
+	}
+}
+
+// problem writes err as problem details. Anything that isn't an *Error is
+// reported as an internal error. It returns a nil response so the generated
+// wrapper doesn't write anything else.
+func (api API) problem(w http.ResponseWriter, r *http.Request, err error) *spec.Response {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		apiErr = errInternal
+	}
+
+	detail := apiErr.Detail
+	instance := r.URL.Path
+	problem := spec.Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(apiErr.Status),
+		Status:   apiErr.Status,
+		Code:     apiErr.Code,
+		Detail:   &detail,
+		Instance: &instance,
+		Errors:   apiErr.Fields,
+	}
+
+	if requestID := middleware.GetReqID(r.Context()); requestID != "" {
+		problem.RequestID = &requestID
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(apiErr.Status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		api.logger.Error("failed to write problem details", zap.Error(err))
+	}
+
+	return nil
+}
+
+// ErrorHandler renders parameter binding errors raised by the generated router
+// as problem details.
+func (api API) ErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	api.problem(w, r, errBadRequest("invalid_parameter", err.Error()))
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-plann.er/internal/api/spec"
+)
+
+// versionETag is the strong ETag of a row with a version column.
+func versionETag(version int32) string {
+	return `"` + strconv.Itoa(int(version)) + `"`
+}
+
+// contentETag is a weak ETag derived from the JSON representation of body,
+// used for collections that have no version of their own.
+func contentETag(body any) string {
+	b, _ := json.Marshal(body)
+	sum := sha256.Sum256(b)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether tag is listed in an If-Match or If-None-Match
+// header value. If-None-Match uses the weak comparison, which compares tags by
+// their opaque part. If-Match uses the strong one, where a weak tag never
+// matches (RFC 9110, section 13.1.1).
+func etagMatches(header, tag string, weak bool) bool {
+	if weak {
+		tag = strings.TrimPrefix(tag, "W/")
+	} else if strings.HasPrefix(tag, "W/") {
+		return strings.TrimSpace(header) == "*"
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if weak {
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionalGet sets the ETag header and returns a 304 response when the
+// client's copy, as given in If-None-Match, is still current.
+func conditionalGet(w http.ResponseWriter, ifNoneMatch *string, tag string) *spec.Response {
+	w.Header().Set("ETag", tag)
+	if ifNoneMatch != nil && etagMatches(*ifNoneMatch, tag, true) {
+		return &spec.Response{Code: http.StatusNotModified}
+	}
+	return nil
+}
+
+// preconditionFailed reports whether the client sent If-Match and it doesn't
+// list the current version.
+func preconditionFailed(ifMatch *string, version int32) bool {
+	return ifMatch != nil && !etagMatches(*ifMatch, versionETag(version), false)
+}
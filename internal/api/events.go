@@ -0,0 +1,300 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	// tripEventsChannel is where Postgres announces the trips with new events,
+	// see migration 023.
+	tripEventsChannel = "trip_events"
+
+	tripEventsBatch   = 100
+	keepAliveInterval = 15 * time.Second
+	// tripEventRetention is how long events are kept, and so how far back an
+	// event stream can resume.
+	tripEventRetention = 30 * 24 * time.Hour
+)
+
+// Stream the changes to a trip.
+// (GET /trips/{tripId}/events)
+func (api API) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDEventsParams) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	var lastEventID int64
+	if params.LastEventID != nil {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil || id < 0 {
+			return api.problem(w, r, errBadRequest("invalid_last_event_id", "Last-Event-ID must be the id of an event"))
+		}
+		lastEventID = id
+	}
+
+	// Subscribing before looking up where the stream starts means no event can
+	// slip in between.
+	wake, unsubscribe := api.events.subscribe(trip.ID)
+	defer unsubscribe()
+
+	var cursor tripEventsCursor
+	var err error
+	if params.LastEventID != nil {
+		cursor, err = api.tripEventsAfter(r.Context(), trip.ID, lastEventID)
+	} else {
+		cursor, err = api.tripEventsFromNow(r.Context())
+	}
+	if err != nil {
+		api.logger.Error("failed to find where trip events start", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	// The stream is written straight to the client, like problem details, and
+	// is kept open well past the server write timeout.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		api.logger.Warn("failed to lift write deadline for trip events", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		events, err := api.store.GetTripEvents(r.Context(), pgstore.GetTripEventsParams{
+			TripID:      trip.ID,
+			AfterXactID: cursor.xactID,
+			AfterID:     cursor.id,
+			MaxResults:  tripEventsBatch,
+		})
+		if err != nil {
+			// The client reconnects with the id of the last event it got.
+			if r.Context().Err() == nil {
+				api.logger.Error("failed to get trip events", zap.Error(err), zap.String("trip_id", tripID))
+			}
+			return nil
+		}
+
+		for _, event := range events {
+			if err := writeTripEvent(w, event); err != nil {
+				return nil
+			}
+			cursor = tripEventsCursor{event.XactID, event.ID}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+
+		if len(events) == tripEventsBatch {
+			continue
+		}
+
+		// Waking up on the keep-alive as well picks up any event whose
+		// notification got lost, or that was held back by a transaction
+		// running long.
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-wake:
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// tripEventsCursor is where a reader of the events of a trip is at: after
+// the event id of the transaction xactID. Events are read in the order of the
+// transactions that logged them, and only once every older transaction has
+// finished, see migration 023, so one that commits late isn't skipped.
+type tripEventsCursor struct {
+	xactID int64
+	id     int64
+}
+
+// tripEventsFromNow returns the cursor right before the events still to
+// come.
+func (api API) tripEventsFromNow(ctx context.Context) (tripEventsCursor, error) {
+	horizon, err := api.store.GetTripEventsHorizon(ctx)
+	if err != nil {
+		return tripEventsCursor{}, err
+	}
+	return tripEventsCursor{xactID: horizon}, nil
+}
+
+// tripEventsAfter returns the cursor right after an event of a trip. When the
+// event is no longer kept, the cursor is before the oldest event kept.
+func (api API) tripEventsAfter(ctx context.Context, tripID uuid.UUID, eventID int64) (tripEventsCursor, error) {
+	xactID, err := api.store.GetTripEventXactID(ctx, pgstore.GetTripEventXactIDParams{ID: eventID, TripID: tripID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tripEventsCursor{}, nil
+		}
+		return tripEventsCursor{}, err
+	}
+	return tripEventsCursor{xactID: xactID, id: eventID}, nil
+}
+
+// writeTripEvent writes event to an event stream, named after its type.
+func writeTripEvent(w io.Writer, event pgstore.TripEvent) error {
+	data, err := json.Marshal(tripEventResponse(event))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
+
+func tripEventResponse(event pgstore.TripEvent) spec.TripEvent {
+	return spec.TripEvent{
+		ID:         strconv.FormatInt(event.ID, 10),
+		Type:       tripEventType(event.Type),
+		TripID:     event.TripID.String(),
+		SubjectID:  event.SubjectID.String(),
+		Fields:     event.Fields,
+		OccurredAt: event.CreatedAt.Time,
+	}
+}
+
+// tripEventType converts an event type stored in the database. Types are only
+// logged by the triggers of migration 023, which stick to the values in the
+// spec.
+func tripEventType(t string) spec.TripEventType {
+	var et spec.TripEventType
+	_ = et.FromValue(t)
+	return et
+}
+
+// PruneTripEvents deletes the events older than tripEventRetention and reports
+// how many went. Events a webhook delivery or chat channel is still waiting to
+// send are kept until they are sent.
+func (api API) PruneTripEvents(ctx context.Context) (int64, error) {
+	return api.store.DeleteOldTripEvents(ctx, int32(tripEventRetention/time.Second))
+}
+
+// ListenTripEvents listens for the trips Postgres announces new events or
+// presence changes for and wakes their event streams and collaboration
+// channels open on this instance, until ctx is done or the connection fails.
+// Every instance listens, so clients see the changes made through any of them.
+func (api API) ListenTripEvents(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, api.pool.Config().ConnConfig)
+	if err != nil {
+		return fmt.Errorf("api: failed to connect to listen for trip events: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	brokers := map[string]*broker{
+		tripEventsChannel:   api.events,
+		tripPresenceChannel: api.presence,
+	}
+	for channel, b := range brokers {
+		if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+			return fmt.Errorf("api: failed to listen for trip events on %s: %w", channel, err)
+		}
+
+		// Changes made while nothing was listening are picked up now.
+		b.wakeAll()
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		tripID, err := uuid.Parse(notification.Payload)
+		if err != nil {
+			api.logger.Warn(
+				"ignored malformed trip notification",
+				zap.String("channel", notification.Channel),
+				zap.String("payload", notification.Payload),
+			)
+			continue
+		}
+
+		if b, ok := brokers[notification.Channel]; ok {
+			b.wake(tripID)
+		}
+	}
+}
+
+// broker keeps track of the event streams or collaboration channels open on
+// this instance, by trip.
+type broker struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{streams: make(map[uuid.UUID]map[chan struct{}]struct{})}
+}
+
+// subscribe registers a stream of a trip. The channel receives a value when
+// the trip may have changed, wakes that come while the stream is busy are
+// merged into one.
+func (b *broker) subscribe(tripID uuid.UUID) (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.streams[tripID] == nil {
+		b.streams[tripID] = make(map[chan struct{}]struct{})
+	}
+	b.streams[tripID][wake] = struct{}{}
+
+	return wake, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.streams[tripID], wake)
+		if len(b.streams[tripID]) == 0 {
+			delete(b.streams, tripID)
+		}
+	}
+}
+
+func (b *broker) wake(tripID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for stream := range b.streams[tripID] {
+		notify(stream)
+	}
+}
+
+func (b *broker) wakeAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, streams := range b.streams {
+		for stream := range streams {
+			notify(stream)
+		}
+	}
+}
+
+func notify(stream chan struct{}) {
+	select {
+	case stream <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,417 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/currency"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Log a shared expense on a trip.
+// (POST /trips/{tripId}/expenses)
+func (api API) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(participants) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+	}
+
+	members := make(map[uuid.UUID]struct{}, len(participants))
+	for _, participant := range participants {
+		if participant.ID.Valid {
+			members[participant.ID.Bytes] = struct{}{}
+		}
+	}
+
+	params := pgstore.CreateExpenseParams{
+		TripID:      id,
+		PayerID:     uuid.MustParse(body.PayerID),
+		Description: body.Description,
+		Currency:    body.Currency,
+		SplitMode:   body.SplitMode.ToValue(),
+		SpentAt:     pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+		Category:    spec.ExpenseCategoryOther.ToValue(),
+	}
+	params.Amount, _ = currency.Parse(body.Amount, body.Currency)
+	if body.SpentAt != nil {
+		params.SpentAt.Time = *body.SpentAt
+	}
+
+	if _, ok := members[params.PayerID]; !ok {
+		return api.problem(w, r, errInvalidField("payer_id", "trip_participant", "must be a participant of the trip"))
+	}
+
+	for i, split := range body.Splits {
+		if _, ok := members[uuid.MustParse(split.ParticipantID)]; !ok {
+			return api.problem(w, r, errInvalidField(fmt.Sprintf("splits[%d].participant_id", i), "trip_participant", "must be a participant of the trip"))
+		}
+	}
+
+	if body.ActivityID != nil {
+		activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: uuid.MustParse(*body.ActivityID), TripID: id})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return api.problem(w, r, errInvalidField("activity_id", "trip_activity", "must be an activity of the trip"))
+			}
+
+			api.logger.Error("failed to get activity", zap.Error(err), zap.String("trip_id", tripID))
+			return api.problem(w, r, errInternal)
+		}
+		params.ActivityID = pgtype.UUID{Bytes: activity.ID, Valid: true}
+		params.Category = spec.ExpenseCategoryActivities.ToValue()
+	}
+
+	if body.Category != nil {
+		params.Category = body.Category.ToValue()
+	}
+
+	expenseID, err := api.store.LogExpense(r.Context(), api.pool, params, expenseSplits(params.Amount, body))
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to log expense", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	go api.checkBudget(id, params.Category)
+
+	return spec.PostTripsTripIDExpensesJSON201Response(spec.CreateExpenseResponse{
+		ExpenseID: expenseID.String(),
+	})
+}
+
+// Get a trip expenses.
+// (GET /trips/{tripId}/expenses)
+func (api API) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	expenses, splits, ok := api.getTripExpenses(w, r, id)
+	if !ok {
+		return nil
+	}
+
+	response := spec.GetExpensesResponse{Expenses: make([]spec.Expense, 0, len(expenses))}
+	for _, expense := range expenses {
+		response.Expenses = append(response.Expenses, expenseResponse(expense, splits[expense.ID]))
+	}
+
+	return spec.GetTripsTripIDExpensesJSON200Response(response)
+}
+
+// Get a trip expense.
+// (GET /trips/{tripId}/expenses/{expenseId})
+func (api API) GetTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *spec.Response {
+	params, ok := api.expenseParams(w, r, tripID, expenseID)
+	if !ok {
+		return nil
+	}
+
+	expense, err := api.store.GetExpense(r.Context(), params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("expense_not_found", "expense not found"))
+		}
+
+		api.logger.Error("failed to get expense", zap.Error(err), zap.String("expense_id", expenseID))
+		return api.problem(w, r, errInternal)
+	}
+
+	splits, err := api.store.GetExpenseSplits(r.Context(), expense.ID)
+	if err != nil {
+		api.logger.Error("failed to get expense splits", zap.Error(err), zap.String("expense_id", expenseID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.GetTripsTripIDExpensesExpenseIDJSON200Response(spec.GetExpenseResponse{
+		Expense: expenseResponse(expense, splits),
+	})
+}
+
+// Delete a trip expense.
+// (DELETE /trips/{tripId}/expenses/{expenseId})
+func (api API) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *spec.Response {
+	params, ok := api.expenseParams(w, r, tripID, expenseID)
+	if !ok {
+		return nil
+	}
+
+	deleted, err := api.store.DeleteExpense(r.Context(), pgstore.DeleteExpenseParams(params))
+	if err != nil {
+		api.logger.Error("failed to delete expense", zap.Error(err), zap.String("expense_id", expenseID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errNotFound("expense_not_found", "expense not found"))
+	}
+
+	return spec.DeleteTripsTripIDExpensesExpenseIDJSON204Response(nil)
+}
+
+// Get who owes what on a trip and how to settle up.
+// (GET /trips/{tripId}/balances)
+func (api API) GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	expenses, splits, ok := api.getTripExpenses(w, r, trip.ID)
+	if !ok {
+		return nil
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	type ledger struct {
+		total      int64
+		paid, owed map[uuid.UUID]int64
+	}
+	ledgers := make(map[string]*ledger)
+	tables := make(map[time.Time]currency.Table)
+	for _, expense := range expenses {
+		code, total := expense.Currency, expense.Amount
+		owed := make([]int64, len(splits[expense.ID]))
+		for i, split := range splits[expense.ID] {
+			owed[i] = split.Amount
+		}
+
+		if trip.HomeCurrency.Valid {
+			code = trip.HomeCurrency.String
+			if total, err = api.convert(r.Context(), tables, expense.Amount, expense.Currency, code, expense.SpentAt.Time); err != nil {
+				var apiErr *Error
+				if !errors.As(err, &apiErr) {
+					api.logger.Error("failed to convert expense", zap.Error(err), zap.String("expense_id", expense.ID.String()))
+				}
+				return api.problem(w, r, err)
+			}
+			if len(owed) > 0 {
+				owed = allocate(total, owed)
+			}
+		}
+
+		l, ok := ledgers[code]
+		if !ok {
+			l = &ledger{paid: make(map[uuid.UUID]int64), owed: make(map[uuid.UUID]int64)}
+			ledgers[code] = l
+		}
+
+		l.total += total
+		l.paid[expense.PayerID] += total
+		for i, split := range splits[expense.ID] {
+			l.owed[split.ParticipantID] += owed[i]
+		}
+	}
+
+	response := spec.GetBalancesResponse{Currencies: make([]spec.CurrencyBalances, 0, len(ledgers))}
+	for code, l := range ledgers {
+		balances := spec.CurrencyBalances{
+			Currency:  code,
+			Total:     currency.Format(l.total, code),
+			Balances:  []spec.ParticipantBalance{},
+			Transfers: []spec.SettleUpTransfer{},
+		}
+
+		net := make(map[uuid.UUID]int64)
+		for _, participant := range participants {
+			if !participant.ID.Valid {
+				continue
+			}
+
+			pid := uuid.UUID(participant.ID.Bytes)
+			paid, hasPaid := l.paid[pid]
+			owed, hasOwed := l.owed[pid]
+			if !hasPaid && !hasOwed {
+				continue
+			}
+
+			net[pid] = paid - owed
+			balances.Balances = append(balances.Balances, spec.ParticipantBalance{
+				ParticipantID: pid.String(),
+				Name:          participantName(pgstore.Participant{Email: participant.Email.String, Name: participant.Name}),
+				Paid:          currency.Format(paid, code),
+				Owed:          currency.Format(owed, code),
+				Net:           currency.Format(paid-owed, code),
+			})
+		}
+
+		for _, t := range settleUp(net) {
+			balances.Transfers = append(balances.Transfers, spec.SettleUpTransfer{
+				FromParticipantID: t.from.String(),
+				ToParticipantID:   t.to.String(),
+				Amount:            currency.Format(t.amount, code),
+			})
+		}
+
+		response.Currencies = append(response.Currencies, balances)
+	}
+
+	slices.SortFunc(response.Currencies, func(a, b spec.CurrencyBalances) int {
+		return cmp.Compare(a.Currency, b.Currency)
+	})
+
+	return spec.GetTripsTripIDBalancesJSON200Response(response)
+}
+
+// expenseParams parses the ids of an expense route. When it reports false the
+// problem has already been written.
+func (api API) expenseParams(w http.ResponseWriter, r *http.Request, tripID, expenseID string) (pgstore.GetExpenseParams, bool) {
+	var params pgstore.GetExpenseParams
+	var err error
+	if params.TripID, err = uuid.Parse(tripID); err == nil {
+		params.ID, err = uuid.Parse(expenseID)
+	}
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.GetExpenseParams{}, false
+	}
+
+	return params, true
+}
+
+// getTripExpenses loads the expenses of a trip along with their splits. When
+// it reports false the problem has already been written.
+func (api API) getTripExpenses(w http.ResponseWriter, r *http.Request, tripID uuid.UUID) ([]pgstore.Expense, map[uuid.UUID][]pgstore.ExpenseSplit, bool) {
+	rows, err := api.store.GetTripExpenses(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip expenses", zap.Error(err), zap.String("trip_id", tripID.String()))
+		api.problem(w, r, errInternal)
+		return nil, nil, false
+	}
+
+	if len(rows) == 0 {
+		api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		return nil, nil, false
+	}
+
+	splitRows, err := api.store.GetTripExpenseSplits(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip expense splits", zap.Error(err), zap.String("trip_id", tripID.String()))
+		api.problem(w, r, errInternal)
+		return nil, nil, false
+	}
+
+	splits := make(map[uuid.UUID][]pgstore.ExpenseSplit)
+	for _, split := range splitRows {
+		splits[split.ExpenseID] = append(splits[split.ExpenseID], pgstore.ExpenseSplit(split))
+	}
+
+	expenses := make([]pgstore.Expense, 0, len(rows))
+	for _, row := range rows {
+		if !row.ID.Valid {
+			continue
+		}
+
+		expenses = append(expenses, pgstore.Expense{
+			ID:          row.ID.Bytes,
+			TripID:      row.TripID,
+			PayerID:     row.PayerID.Bytes,
+			ActivityID:  row.ActivityID,
+			Description: row.Description.String,
+			Amount:      row.Amount.Int64,
+			Currency:    row.Currency.String,
+			SplitMode:   row.SplitMode.String,
+			SpentAt:     row.SpentAt,
+			Category:    row.Category.String,
+		})
+	}
+
+	return expenses, splits, true
+}
+
+// expenseSplits works out what each participant owes of an expense of total
+// minor units. Shares are only kept when splitting by shares.
+func expenseSplits(total int64, body spec.CreateExpenseRequest) []pgstore.CreateExpenseSplitsParams {
+	mode := body.SplitMode.ToValue()
+
+	weights := make([]int64, len(body.Splits))
+	for i, split := range body.Splits {
+		weights[i] = 1
+		if mode == spec.SplitModeShares.ToValue() {
+			weights[i] = int64(*split.Shares)
+		}
+	}
+	amounts := allocate(total, weights)
+
+	splits := make([]pgstore.CreateExpenseSplitsParams, 0, len(body.Splits))
+	for i, split := range body.Splits {
+		params := pgstore.CreateExpenseSplitsParams{
+			ParticipantID: uuid.MustParse(split.ParticipantID),
+			Amount:        amounts[i],
+		}
+		switch mode {
+		case spec.SplitModeShares.ToValue():
+			params.Shares = int4FromInt(split.Shares)
+		case spec.SplitModeExact.ToValue():
+			params.Amount, _ = currency.Parse(*split.Amount, body.Currency)
+		}
+		splits = append(splits, params)
+	}
+	return splits
+}
+
+func expenseResponse(expense pgstore.Expense, splits []pgstore.ExpenseSplit) spec.Expense {
+	var mode spec.SplitMode
+	_ = mode.FromValue(expense.SplitMode)
+
+	var category spec.ExpenseCategory
+	_ = category.FromValue(expense.Category)
+
+	response := spec.Expense{
+		ID:          expense.ID.String(),
+		PayerID:     expense.PayerID.String(),
+		ActivityID:  stringFromUUID(expense.ActivityID),
+		Description: expense.Description,
+		Amount:      currency.Format(expense.Amount, expense.Currency),
+		Currency:    expense.Currency,
+		SpentAt:     expense.SpentAt.Time,
+		Category:    category,
+		SplitMode:   mode,
+		Splits:      make([]spec.ExpenseSplit, 0, len(splits)),
+	}
+
+	for _, split := range splits {
+		response.Splits = append(response.Splits, spec.ExpenseSplit{
+			ParticipantID: split.ParticipantID.String(),
+			Shares:        intFromInt4(split.Shares),
+			Amount:        currency.Format(split.Amount, expense.Currency),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// maxIdempotentBodyBytes is the largest body buffered for hashing, as large
+	// as the biggest upload any POST takes.
+	maxIdempotentBodyBytes = 1 << 20
+)
+
+// Idempotency makes POST requests that carry an Idempotency-Key header safe to
+// retry. The first response for a key is stored and replayed for every retry
+// with the same payload. Keys expire after 24 hours.
+func (api API) Idempotency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > 255 {
+			api.problem(w, r, errBadRequest("invalid_idempotency_key", "idempotency key must be at most 255 characters"))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				api.problem(w, r, &Error{
+					Status: http.StatusRequestEntityTooLarge,
+					Code:   "payload_too_large",
+					Detail: "request body must be at most 1MB",
+				})
+				return
+			}
+
+			api.problem(w, r, errBadRequest("invalid_body", "failed to read request body"))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		sum := sha256.Sum256(append([]byte(r.Method+" "+r.URL.Path+"\n"), body...))
+		hash := hex.EncodeToString(sum[:])
+
+		created, err := api.store.CreateIdempotencyKey(r.Context(), pgstore.CreateIdempotencyKeyParams{
+			Key:         key,
+			RequestHash: hash,
+		})
+		if err != nil {
+			api.logger.Error("failed to create idempotency key", zap.Error(err), zap.String("idempotency_key", key))
+			api.problem(w, r, errInternal)
+			return
+		}
+
+		if created == 0 {
+			api.replay(w, r, key, hash)
+			return
+		}
+
+		// The key is released unless a response gets saved, so a handler that
+		// fails or panics doesn't leave it stuck in progress. The response is
+		// already on its way, so a client that hung up doesn't stop that either.
+		ctx := context.WithoutCancel(r.Context())
+		saved := false
+		defer func() {
+			if saved {
+				return
+			}
+			if err := api.store.DeleteIdempotencyKey(ctx, key); err != nil {
+				api.logger.Error("failed to release idempotency key", zap.Error(err), zap.String("idempotency_key", key))
+			}
+		}()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		var buf bytes.Buffer
+		ww.Tee(&buf)
+
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		if err := api.store.SaveIdempotencyKeyResponse(ctx, pgstore.SaveIdempotencyKeyResponseParams{
+			StatusCode:   pgtype.Int4{Int32: int32(status), Valid: true},
+			ContentType:  pgtype.Text{String: ww.Header().Get("Content-Type"), Valid: true},
+			ResponseBody: buf.Bytes(),
+			Key:          key,
+		}); err != nil {
+			api.logger.Error("failed to save idempotent response", zap.Error(err), zap.String("idempotency_key", key))
+			return
+		}
+		saved = true
+	})
+}
+
+func (api API) replay(w http.ResponseWriter, r *http.Request, key, hash string) {
+	stored, err := api.store.GetIdempotencyKey(r.Context(), key)
+	if err != nil {
+		api.logger.Error("failed to get idempotency key", zap.Error(err), zap.String("idempotency_key", key))
+		api.problem(w, r, errInternal)
+		return
+	}
+
+	if stored.RequestHash != hash {
+		api.problem(w, r, &Error{
+			Status: http.StatusUnprocessableEntity,
+			Code:   "idempotency_key_reused",
+			Detail: "idempotency key was already used for a different request",
+		})
+		return
+	}
+
+	if !stored.StatusCode.Valid {
+		api.problem(w, r, errConflict("idempotency_key_in_progress", "a request with this idempotency key is still being processed"))
+		return
+	}
+
+	if stored.ContentType.String != "" {
+		w.Header().Set("Content-Type", stored.ContentType.String)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(int(stored.StatusCode.Int32))
+	w.Write(stored.ResponseBody) //nolint:errcheck
+}
@@ -0,0 +1,362 @@
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	maxBulkInviteBytes = 1 << 20
+	maxBulkInviteRows  = 500
+
+	// An invitation can be sent at most maxInviteSends times, with at least
+	// inviteResendCooldown between two sends.
+	maxInviteSends       = 5
+	inviteResendCooldown = 10 * time.Minute
+)
+
+// Invite many people to the trip at once.
+// (POST /trips/{tripId}/invites/bulk)
+func (api API) PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request, tripID string, _ spec.PostTripsTripIDInvitesBulkParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	rows, err := readBulkInvites(http.MaxBytesReader(w, r.Body, maxBulkInviteBytes), r.Header.Get("Content-Type"))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return api.problem(w, r, &Error{
+				Status: http.StatusRequestEntityTooLarge,
+				Code:   "payload_too_large",
+				Detail: "upload must be at most 1MB",
+			})
+		}
+
+		return api.problem(w, r, err)
+	}
+
+	if len(rows) == 0 || len(rows) > maxBulkInviteRows {
+		return api.problem(w, r, &Error{
+			Status: http.StatusUnprocessableEntity,
+			Code:   "invalid_row_count",
+			Detail: "upload must have between 1 and 500 rows",
+		})
+	}
+
+	results := make([]spec.BulkInviteResult, len(rows))
+	invites := make([]pgstore.InviteParticipantsToTripParams, 0, len(rows))
+	pending := make(map[string]int, len(rows))
+	for i, row := range rows {
+		result := spec.BulkInviteResult{Row: i + 1, Email: strings.TrimSpace(row.Email)}
+		if row.Name != nil {
+			if name := strings.TrimSpace(*row.Name); name != "" {
+				result.Name = &name
+			}
+		}
+
+		key := strings.ToLower(result.Email)
+		switch {
+		case api.validator.Var(result.Email, "required,email") != nil:
+			result.Status = spec.BulkInviteResultStatusInvalid
+			result.Reason = ptr("must be a valid email")
+		case pending[key] != 0:
+			result.Status = spec.BulkInviteResultStatusDuplicate
+			result.Reason = ptr("listed more than once in the upload")
+		default:
+			pending[key] = i + 1
+			invites = append(invites, pgstore.InviteParticipantsToTripParams{
+				Email: result.Email,
+				Name:  pgtype.Text{String: deref(result.Name), Valid: result.Name != nil},
+			})
+		}
+
+		results[i] = result
+	}
+
+	created, err := api.store.InviteParticipants(r.Context(), api.pool, id, invites)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
+		if pgstore.IsUniqueViolation(err) {
+			return api.problem(w, r, errConflict("participant_already_invited", "a participant was invited concurrently, try again"))
+		}
+
+		api.logger.Error("failed to invite participants to trip", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	for _, i := range pending {
+		results[i-1].Status = spec.BulkInviteResultStatusDuplicate
+		results[i-1].Reason = ptr("already on the trip")
+	}
+
+	participants := make([]mailpit.ParticipantToSendEmail, 0, len(created))
+	for _, participant := range created {
+		i := pending[strings.ToLower(participant.Email)] - 1
+		results[i].Status = spec.BulkInviteResultStatusCreated
+		results[i].Reason = nil
+
+		name := participant.Name.String
+		if !participant.Name.Valid {
+			name = participant.Email[:strings.LastIndex(participant.Email, "@")]
+		}
+		participants = append(participants, mailpit.ParticipantToSendEmail{
+			Name:  name,
+			Email: participant.Email,
+		})
+	}
+
+	response := spec.BulkInviteResponse{Rows: results}
+	for _, result := range results {
+		switch result.Status {
+		case spec.BulkInviteResultStatusCreated:
+			response.Created++
+		case spec.BulkInviteResultStatusDuplicate:
+			response.Duplicate++
+		case spec.BulkInviteResultStatusInvalid:
+			response.Invalid++
+		}
+	}
+
+	if len(participants) > 0 {
+		emails := make([]string, len(participants))
+		for i, participant := range participants {
+			emails[i] = participant.Email
+		}
+		api.markInvited(r.Context(), id, emails)
+
+		go func() {
+			if err := api.mailer.SendConfirmTripEmailToTripParticipants(participants, id); err != nil {
+				api.logger.Error(
+					"failed to send invite emails on PostTripsTripIDInvitesBulk",
+					zap.Error(err),
+					zap.String("trip_id", id.String()),
+				)
+			}
+		}()
+	}
+
+	return spec.PostTripsTripIDInvitesBulkJSON200Response(response)
+}
+
+// Send the invitation email to a participant again.
+// (POST /participants/{participantId}/resend-invite)
+func (api API) PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if participant.IsConfirmed {
+		return api.problem(w, r, errConflict("participant_already_confirmed", "participant already confirmed"))
+	}
+
+	// Claiming the send in the database keeps concurrent resends from both
+	// getting through the rate limit.
+	claimed, err := api.store.ClaimParticipantInvite(r.Context(), pgstore.ClaimParticipantInviteParams{
+		ID:         id,
+		MaxInvites: maxInviteSends,
+		Cooldown:   pgtype.Interval{Microseconds: inviteResendCooldown.Microseconds(), Valid: true},
+	})
+	if err != nil {
+		api.logger.Error("failed to claim participant invite", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if claimed == 0 {
+		if participant.InviteCount >= maxInviteSends {
+			return api.problem(w, r, &Error{
+				Status: http.StatusTooManyRequests,
+				Code:   "invite_limit_reached",
+				Detail: "the invitation was already sent the maximum number of times",
+			})
+		}
+
+		retryAfter := inviteResendCooldown - time.Since(participant.LastInvitedAt.Time)
+		w.Header().Set("Retry-After", strconv.Itoa(int(max(retryAfter, time.Second).Seconds())))
+		return api.problem(w, r, &Error{
+			Status: http.StatusTooManyRequests,
+			Code:   "invite_recently_sent",
+			Detail: "the invitation was sent recently, try again later",
+		})
+	}
+
+	go func() {
+		if err := api.mailer.SendConfirmTripEmailToTripParticipant(mailpit.ParticipantToSendEmail{
+			Name:  participantName(participant),
+			Email: participant.Email,
+		}, participant.TripID); err != nil {
+			api.logger.Error(
+				"failed to resend invite email on PostParticipantsParticipantIDResendInvite",
+				zap.Error(err),
+				zap.String("participant_id", participantID),
+			)
+		}
+	}()
+
+	return spec.PostParticipantsParticipantIDResendInviteJSON204Response(nil)
+}
+
+// Revoke a participant's invitation.
+// (POST /participants/{participantId}/revoke)
+func (api API) PostParticipantsParticipantIDRevoke(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	// The participant ID is what invitation emails link to, so removing the
+	// row is what invalidates them.
+	promoted, err := api.store.RemoveParticipant(r.Context(), api.pool, participant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+		if errors.Is(err, pgstore.ErrParticipantHasExpenses) {
+			return api.problem(w, r, errConflict("participant_has_expenses", "participant paid for or shares in expenses of the trip, remove those first"))
+		}
+
+		api.logger.Error("failed to remove participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	api.notifyPromoted(promoted)
+
+	go func() {
+		if err := api.mailer.SendInviteRevokedEmailToParticipant(mailpit.ParticipantToSendEmail{
+			Name:  participantName(participant),
+			Email: participant.Email,
+		}, participant.TripID); err != nil {
+			api.logger.Error(
+				"failed to send revoked invite email on PostParticipantsParticipantIDRevoke",
+				zap.Error(err),
+				zap.String("participant_id", participantID),
+			)
+		}
+	}()
+
+	return spec.PostParticipantsParticipantIDRevokeJSON204Response(nil)
+}
+
+// markInvited records that invitation emails are going out to emails. The
+// counts only feed resend rate limiting, so a failure is logged and ignored.
+func (api API) markInvited(ctx context.Context, tripID uuid.UUID, emails []string) {
+	if err := api.store.MarkParticipantsInvited(ctx, pgstore.MarkParticipantsInvitedParams{
+		TripID: tripID,
+		Emails: emails,
+	}); err != nil {
+		api.logger.Error("failed to mark participants invited", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+func participantName(participant pgstore.Participant) string {
+	if participant.Name.Valid {
+		return participant.Name.String
+	}
+	return participant.Email[:strings.LastIndex(participant.Email, "@")]
+}
+
+// readBulkInvites decodes a bulk invite upload, either a JSON array or CSV
+// rows of name,email. A CSV header row is skipped, and a row with a single
+// column is read as an email without a name.
+func readBulkInvites(body io.Reader, contentType string) ([]spec.BulkInviteRow, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json":
+		var rows spec.BulkInviteRequest
+		if err := json.NewDecoder(body).Decode(&rows); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return nil, err
+			}
+			return nil, errBadRequest("invalid_json", "invalid json")
+		}
+		return rows, nil
+
+	case "text/csv":
+		reader := csv.NewReader(body)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		var rows []spec.BulkInviteRow
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					return nil, err
+				}
+				return nil, errBadRequest("invalid_csv", err.Error())
+			}
+
+			row := spec.BulkInviteRow{Email: record[len(record)-1]}
+			if len(record) > 1 {
+				row.Name = &record[0]
+			}
+
+			if len(rows) == 0 && strings.EqualFold(strings.TrimSpace(row.Email), "email") {
+				continue
+			}
+
+			rows = append(rows, row)
+		}
+
+	default:
+		return nil, errUnsupportedMediaType("content type must be application/json or text/csv")
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func deref[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Create a shareable join link for the trip.
+// (POST /trips/{tripId}/join-links)
+func (api API) PostTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateJoinLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	code, err := newJoinCode()
+	if err != nil {
+		api.logger.Error("failed to generate join code", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	params := pgstore.CreateJoinLinkParams{
+		TripID: id,
+		Code:   code,
+		Role:   spec.ParticipantRoleEditor.ToValue(),
+	}
+	if body.Role != nil {
+		params.Role = body.Role.ToValue()
+	}
+	if body.MaxUses != nil {
+		params.MaxUses = pgtype.Int4{Int32: int32(*body.MaxUses), Valid: true}
+	}
+	if body.ExpiresAt != nil {
+		params.ExpiresAt = pgtype.Timestamp{Time: *body.ExpiresAt, Valid: true}
+	}
+
+	joinLinkID, err := api.store.CreateJoinLink(r.Context(), params)
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
+		api.logger.Error("failed to create join link", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDJoinLinksJSON201Response(spec.CreateJoinLinkResponse{
+		JoinLinkID: joinLinkID.String(),
+		Code:       code,
+	})
+}
+
+// Get a trip join links.
+// (GET /trips/{tripId}/join-links)
+func (api API) GetTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	joinLinks, err := api.store.GetTripJoinLinks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip join links", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(joinLinks) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+	}
+
+	response := make([]spec.GetJoinLinksResponseArray, 0, len(joinLinks))
+	for _, joinLink := range joinLinks {
+		if !joinLink.ID.Valid {
+			continue
+		}
+
+		item := spec.GetJoinLinksResponseArray{
+			ID:        uuid.UUID(joinLink.ID.Bytes).String(),
+			Code:      joinLink.Code.String,
+			Role:      participantRole(joinLink.Role.String),
+			Uses:      int(joinLink.Uses.Int32),
+			CreatedAt: joinLink.CreatedAt.Time,
+		}
+		if joinLink.MaxUses.Valid {
+			item.MaxUses = ptr(int(joinLink.MaxUses.Int32))
+		}
+		if joinLink.ExpiresAt.Valid {
+			item.ExpiresAt = &joinLink.ExpiresAt.Time
+		}
+		if joinLink.RevokedAt.Valid {
+			item.RevokedAt = &joinLink.RevokedAt.Time
+		}
+
+		response = append(response, item)
+	}
+
+	return spec.GetTripsTripIDJoinLinksJSON200Response(spec.GetJoinLinksResponse{JoinLinks: response})
+}
+
+// Revoke a trip join link.
+// (DELETE /trips/{tripId}/join-links/{joinLinkId})
+func (api API) DeleteTripsTripIDJoinLinksJoinLinkID(w http.ResponseWriter, r *http.Request, tripID string, joinLinkID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	linkID, err := uuid.Parse(joinLinkID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	revoked, err := api.store.RevokeJoinLink(r.Context(), pgstore.RevokeJoinLinkParams{
+		ID:     linkID,
+		TripID: id,
+	})
+	if err != nil {
+		api.logger.Error("failed to revoke join link", zap.Error(err), zap.String("join_link_id", joinLinkID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if revoked == 0 {
+		return api.problem(w, r, errNotFound("join_link_not_found", "join link not found"))
+	}
+
+	return spec.DeleteTripsTripIDJoinLinksJoinLinkIDJSON204Response(nil)
+}
+
+// Join a trip through a shareable link.
+// (POST /join/{code})
+func (api API) PostJoinCode(w http.ResponseWriter, r *http.Request, code string) *spec.Response {
+	var body spec.JoinTripRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	params := pgstore.JoinTripParams{
+		Code:  code,
+		Email: string(body.Email),
+	}
+	if body.Name != nil {
+		if name := strings.TrimSpace(*body.Name); name != "" {
+			params.Name = pgtype.Text{String: name, Valid: true}
+		}
+	}
+
+	participant, err := api.store.JoinTrip(r.Context(), api.pool, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("join_link_not_found", "join link not found"))
+		case errors.Is(err, pgstore.ErrJoinLinkUnusable):
+			return api.problem(w, r, &Error{
+				Status: http.StatusGone,
+				Code:   "join_link_unusable",
+				Detail: "join link was revoked, has expired or has no uses left",
+			})
+		case errors.Is(err, pgstore.ErrAlreadyParticipant):
+			return api.problem(w, r, errConflict("participant_already_invited", "participant already invited"))
+		}
+
+		api.logger.Error("failed to join trip", zap.Error(err), zap.String("join_code", code))
+		return api.problem(w, r, errInternal)
+	}
+
+	api.markInvited(r.Context(), participant.TripID, []string{participant.Email})
+
+	go func() {
+		if err := api.mailer.SendConfirmTripEmailToTripParticipant(mailpit.ParticipantToSendEmail{
+			Name:  participantName(participant),
+			Email: participant.Email,
+		}, participant.TripID); err != nil {
+			api.logger.Error(
+				"failed to send confirmation email on PostJoinCode",
+				zap.Error(err),
+				zap.String("trip_id", participant.TripID.String()),
+			)
+		}
+	}()
+
+	return spec.PostJoinCodeJSON201Response(spec.JoinTripResponse{
+		ParticipantID: participant.ID.String(),
+		TripID:        participant.TripID.String(),
+	})
+}
+
+// newJoinCode returns a random code that is short enough to share in a chat
+// and long enough not to be guessed.
+func newJoinCode() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// participantRole converts a role stored in the database. The column is
+// constrained to the values in the spec, so the conversion can't fail.
+func participantRole(role string) spec.ParticipantRole {
+	var r spec.ParticipantRole
+	_ = r.FromValue(role)
+	return r
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+var errRouteOutsideTrip = errConflict("route_outside_trip", "the trip dates must cover every leg of its route, update the route first")
+
+// Replace the route of a trip.
+// (PUT /trips/{tripId}/route)
+func (api API) PutTripsTripIDRoute(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDRouteParams) *spec.Response {
+	var body spec.RouteRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	trip := tripFromContext(r.Context())
+	if preconditionFailed(params.IfMatch, trip.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	if n := len(body.Legs); n > 0 {
+		if body.Legs[0].StartsOn.Before(dateOf(trip.StartsAt.Time)) {
+			return api.problem(w, r, errInvalidField("legs[0].starts_on", "trip_window", "must not be before the trip starts"))
+		}
+		if body.Legs[n-1].EndsOn.After(dateOf(trip.EndsAt.Time)) {
+			return api.problem(w, r, errInvalidField(fmt.Sprintf("legs[%d].ends_on", n-1), "trip_window", "must not be after the trip ends"))
+		}
+	}
+
+	legs := make([]pgstore.TripLeg, 0, len(body.Legs))
+	for _, leg := range body.Legs {
+		stored := pgstore.TripLeg{
+			Destination: leg.Destination,
+			StartsOn:    pgtype.Date{Time: leg.StartsOn.Time, Valid: true},
+			EndsOn:      pgtype.Date{Time: leg.EndsOn.Time, Valid: true},
+			Timezone:    leg.Timezone,
+		}
+		if leg.ID != nil {
+			stored.ID = uuid.MustParse(*leg.ID)
+		}
+		legs = append(legs, stored)
+	}
+
+	route, err := api.store.ReplaceTripLegs(r.Context(), api.pool, pgstore.BumpTripVersionParams{ID: trip.ID, Version: trip.Version}, legs)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrVersionMismatch):
+			return api.problem(w, r, errPreconditionFailed)
+		case errors.Is(err, pgstore.ErrUnknownTripLeg):
+			return api.problem(w, r, errInvalidField("legs", "trip_leg", "ids must be legs of the trip"))
+		}
+
+		api.logger.Error("failed to replace trip legs", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	w.Header().Set("ETag", versionETag(trip.Version+1))
+	return spec.PutTripsTripIDRouteJSON200Response(spec.RouteResponse{Route: routeResponse(route)})
+}
+
+// tripLegs loads the route of a trip. When it reports false the problem has
+// already been written.
+func (api API) tripLegs(w http.ResponseWriter, r *http.Request, tripID uuid.UUID) ([]pgstore.TripLeg, bool) {
+	legs, err := api.store.GetTripLegs(r.Context(), tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip legs", zap.Error(err), zap.String("trip_id", tripID.String()))
+		api.problem(w, r, errInternal)
+		return nil, false
+	}
+	return legs, true
+}
+
+// activityLeg resolves the leg an activity is assigned to, checking that it
+// belongs to the trip and that the activity occurs during it. When it reports
+// false the problem has already been written.
+func (api API) activityLeg(w http.ResponseWriter, r *http.Request, tripID uuid.UUID, legID *string, occursAt time.Time) (pgtype.UUID, bool) {
+	if legID == nil {
+		return pgtype.UUID{}, true
+	}
+
+	leg, err := api.store.GetTripLeg(r.Context(), pgstore.GetTripLegParams{ID: uuid.MustParse(*legID), TripID: tripID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errInvalidField("leg_id", "trip_leg", "must be a leg of the trip"))
+			return pgtype.UUID{}, false
+		}
+
+		api.logger.Error("failed to get trip leg", zap.Error(err), zap.String("trip_id", tripID.String()))
+		api.problem(w, r, errInternal)
+		return pgtype.UUID{}, false
+	}
+
+	if !occursDuring(leg, occursAt) {
+		api.problem(w, r, errInvalidField("occurs_at", "leg_dates", "must fall within the dates of the leg, in "+leg.Timezone))
+		return pgtype.UUID{}, false
+	}
+
+	return pgtype.UUID{Bytes: leg.ID, Valid: true}, true
+}
+
+// occursDuring reports whether t falls on one of the days of leg, as seen in
+// the leg's time zone.
+func occursDuring(leg pgstore.TripLeg, t time.Time) bool {
+	loc, err := time.LoadLocation(leg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := t.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	return !day.Before(leg.StartsOn.Time) && !day.After(leg.EndsOn.Time)
+}
+
+// routeFits reports whether every leg lies within the days from startsAt to
+// endsAt.
+func routeFits(legs []pgstore.TripLeg, startsAt, endsAt time.Time) bool {
+	for _, leg := range legs {
+		if leg.StartsOn.Time.Before(dateOf(startsAt)) || leg.EndsOn.Time.After(dateOf(endsAt)) {
+			return false
+		}
+	}
+	return true
+}
+
+// dateOf is the UTC day t falls on, comparable with the dates of legs.
+func dateOf(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+func routeResponse(legs []pgstore.TripLeg) []spec.TripLeg {
+	response := make([]spec.TripLeg, 0, len(legs))
+	for _, leg := range legs {
+		response = append(response, spec.TripLeg{
+			ID:          leg.ID.String(),
+			Destination: leg.Destination,
+			StartsOn:    types.Date{Time: leg.StartsOn.Time},
+			EndsOn:      types.Date{Time: leg.EndsOn.Time},
+			Timezone:    leg.Timezone,
+		})
+	}
+	return response
+}
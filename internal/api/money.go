@@ -0,0 +1,163 @@
+package api
+
+import (
+	"cmp"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/currency"
+)
+
+// Amounts travel as decimal strings, so they never pass through a float, and
+// are stored as integers of the currency's minor unit. How many decimals an
+// amount may have depends on its currency, which validateCreateExpense checks.
+var amountPattern = regexp.MustCompile(`^[0-9]{1,13}(\.[0-9]{1,4})?$`)
+
+// validateAmount checks that a string is a positive decimal amount.
+func validateAmount(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	return amountPattern.MatchString(s) && strings.Trim(s, "0.") != ""
+}
+
+// validateCurrency checks that a string is an active ISO 4217 code.
+func validateCurrency(fl validator.FieldLevel) bool {
+	return currency.Valid(fl.Field().String())
+}
+
+// validateRate checks that a string is a positive decimal exchange rate.
+func validateRate(fl validator.FieldLevel) bool {
+	_, err := currency.ParseRate(fl.Field().String())
+	return err == nil
+}
+
+// estimate splits an optional cost into the amount and currency columns it is
+// stored in.
+func estimate(cost *spec.Money) (pgtype.Int8, pgtype.Text) {
+	if cost == nil {
+		return pgtype.Int8{}, pgtype.Text{}
+	}
+
+	amount, _ := currency.Parse(cost.Amount, cost.Currency)
+	return pgtype.Int8{Int64: amount, Valid: true}, pgtype.Text{String: cost.Currency, Valid: true}
+}
+
+func moneyResponse(amount pgtype.Int8, code pgtype.Text) *spec.Money {
+	if !amount.Valid || !code.Valid {
+		return nil
+	}
+	return &spec.Money{Amount: currency.Format(amount.Int64, code.String), Currency: code.String}
+}
+
+// ratFromNumeric reads a NUMERIC column exactly.
+func ratFromNumeric(n pgtype.Numeric) *big.Rat {
+	exp := int64(n.Exp)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(max(exp, -exp)), nil))
+
+	rat := new(big.Rat).SetInt(n.Int)
+	if exp < 0 {
+		return rat.Quo(rat, scale)
+	}
+	return rat.Mul(rat, scale)
+}
+
+// numericFromRat writes a rate with a finite decimal expansion, as every rate
+// read by currency.ParseRate has, into a NUMERIC column.
+func numericFromRat(rat *big.Rat) pgtype.Numeric {
+	var n pgtype.Numeric
+	_ = n.Scan(currency.FormatRate(rat))
+	return n
+}
+
+// allocate divides total in proportion to weights. The cents lost to rounding
+// go to the largest remainders first, earlier parts winning ties, so the parts
+// always add up to total. The products are worked out with big.Int, since a
+// large amount times a large weight doesn't fit an int64.
+func allocate(total int64, weights []int64) []int64 {
+	sum := new(big.Int)
+	for _, w := range weights {
+		sum.Add(sum, big.NewInt(w))
+	}
+
+	parts := make([]int64, len(weights))
+	remainders := make([]*big.Int, len(weights))
+	left := total
+	for i, w := range weights {
+		product := new(big.Int).Mul(big.NewInt(total), big.NewInt(w))
+		quo, rem := new(big.Int).QuoRem(product, sum, new(big.Int))
+		parts[i] = quo.Int64()
+		remainders[i] = rem
+		left -= parts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return remainders[b].Cmp(remainders[a])
+	})
+
+	for _, i := range order[:left] {
+		parts[i]++
+	}
+	return parts
+}
+
+type transfer struct {
+	from, to uuid.UUID
+	amount   int64
+}
+
+// settleUp turns net balances, positive for those who are owed money, into
+// transfers that clear them. Matching the largest debtor with the largest
+// creditor each time needs at most one transfer fewer than there are non-zero
+// balances.
+func settleUp(net map[uuid.UUID]int64) []transfer {
+	type balance struct {
+		id     uuid.UUID
+		amount int64
+	}
+
+	var creditors, debtors []balance
+	for id, amount := range net {
+		switch {
+		case amount > 0:
+			creditors = append(creditors, balance{id, amount})
+		case amount < 0:
+			debtors = append(debtors, balance{id, -amount})
+		}
+	}
+
+	// Sorting by id on equal amounts keeps the result stable between calls,
+	// map iteration order being random.
+	byAmount := func(a, b balance) int {
+		if c := cmp.Compare(b.amount, a.amount); c != 0 {
+			return c
+		}
+		return slices.Compare(a.id[:], b.id[:])
+	}
+	slices.SortFunc(creditors, byAmount)
+	slices.SortFunc(debtors, byAmount)
+
+	var transfers []transfer
+	for len(creditors) > 0 && len(debtors) > 0 {
+		amount := min(creditors[0].amount, debtors[0].amount)
+		transfers = append(transfers, transfer{from: debtors[0].id, to: creditors[0].id, amount: amount})
+
+		creditors[0].amount -= amount
+		debtors[0].amount -= amount
+		if creditors[0].amount == 0 {
+			creditors = creditors[1:]
+		}
+		if debtors[0].amount == 0 {
+			debtors = debtors[1:]
+		}
+	}
+	return transfers
+}
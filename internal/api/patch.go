@@ -0,0 +1,48 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// mergePatch applies a JSON Merge Patch (RFC 7386) to doc. Members set to null
+// in patch are removed, objects are merged recursively and everything else
+// replaces the value in doc.
+func mergePatch(doc, patch []byte) ([]byte, error) {
+	var target, p any
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(patch))
+	dec.UseNumber()
+	if err := dec.Decode(&p); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergeValue(target, p))
+}
+
+func mergeValue(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergeValue(t[k], v)
+	}
+
+	return t
+}
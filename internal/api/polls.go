@@ -0,0 +1,379 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Open a poll on a trip.
+// (POST /trips/{tripId}/polls)
+func (api API) PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreatePollRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	pollID, err := api.store.OpenPoll(r.Context(), api.pool, pgstore.CreatePollParams{
+		TripID: id,
+		Kind:   body.Kind.ToValue(),
+		Title:  body.Title,
+	}, pollOptionParams(body.Options))
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
+		api.logger.Error("failed to open poll", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDPollsJSON201Response(spec.CreatePollResponse{
+		PollID: pollID.String(),
+	})
+}
+
+// Get a trip polls.
+// (GET /trips/{tripId}/polls)
+func (api API) GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	polls, err := api.store.GetTripPolls(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip polls", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if len(polls) == 0 {
+		return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+	}
+
+	options, err := api.store.GetTripPollOptions(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip poll options", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	optionsByPoll := make(map[uuid.UUID][]pgstore.GetTripPollOptionsRow)
+	for _, option := range options {
+		optionsByPoll[option.PollID] = append(optionsByPoll[option.PollID], option)
+	}
+
+	response := spec.GetPollsResponse{Polls: make([]spec.Poll, 0, len(polls))}
+	for _, row := range polls {
+		if !row.ID.Valid {
+			continue
+		}
+
+		poll := pgstore.Poll{
+			ID:       row.ID.Bytes,
+			TripID:   row.TripID,
+			Kind:     row.Kind.String,
+			Title:    row.Title.String,
+			WinnerID: row.WinnerID,
+			ClosedAt: row.ClosedAt,
+		}
+		response.Polls = append(response.Polls, pollResponse(poll, optionsByPoll[poll.ID]))
+	}
+
+	return spec.GetTripsTripIDPollsJSON200Response(response)
+}
+
+// Get a trip poll.
+// (GET /trips/{tripId}/polls/{pollId})
+func (api API) GetTripsTripIDPollsPollID(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *spec.Response {
+	params, ok := api.pollParams(w, r, tripID, pollID)
+	if !ok {
+		return nil
+	}
+
+	poll, ok := api.getPoll(w, r, params)
+	if !ok {
+		return nil
+	}
+
+	return spec.GetTripsTripIDPollsPollIDJSON200Response(spec.GetPollResponse{Poll: poll})
+}
+
+// Add options to an open poll.
+// (POST /trips/{tripId}/polls/{pollId}/options)
+func (api API) PostTripsTripIDPollsPollIDOptions(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *spec.Response {
+	var body spec.AddPollOptionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	params, ok := api.pollParams(w, r, tripID, pollID)
+	if !ok {
+		return nil
+	}
+
+	poll, err := api.store.GetPoll(r.Context(), pgstore.GetPollParams(params))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("poll_not_found", "poll not found"))
+		}
+
+		api.logger.Error("failed to get poll", zap.Error(err), zap.String("poll_id", pollID))
+		return api.problem(w, r, errInternal)
+	}
+
+	// What makes an option valid depends on the kind of the poll, so the
+	// options are checked the same way as when the poll was opened.
+	if err := api.validator.Struct(spec.CreatePollRequest{
+		Kind:    pollKind(poll.Kind),
+		Title:   poll.Title,
+		Options: body.Options,
+	}); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	optionIDs, err := api.store.AddPollOptions(r.Context(), api.pool, params, pollOptionParams(body.Options))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("poll_not_found", "poll not found"))
+		case errors.Is(err, pgstore.ErrPollClosed):
+			return api.problem(w, r, errConflict("poll_closed", "poll is closed"))
+		}
+
+		api.logger.Error("failed to add poll options", zap.Error(err), zap.String("poll_id", pollID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.AddPollOptionsResponse{OptionIds: make([]string, 0, len(optionIDs))}
+	for _, id := range optionIDs {
+		response.OptionIds = append(response.OptionIds, id.String())
+	}
+
+	return spec.PostTripsTripIDPollsPollIDOptionsJSON201Response(response)
+}
+
+// Cast a participant's ballot on a poll.
+// (PUT /trips/{tripId}/polls/{pollId}/votes/{participantId})
+func (api API) PutTripsTripIDPollsPollIDVotesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, pollID string, participantID string) *spec.Response {
+	var body spec.BallotRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	params, ok := api.pollParams(w, r, tripID, pollID)
+	if !ok {
+		return nil
+	}
+
+	pid, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participant, ok := api.tripParticipant(w, r, params.TripID, pid)
+	if !ok {
+		return nil
+	}
+
+	votes := make([]pgstore.CastPollVoteParams, 0, len(body.Votes))
+	for _, entry := range body.Votes {
+		vote := pgstore.CastPollVoteParams{OptionID: uuid.MustParse(entry.OptionID)}
+		if entry.Vote != nil {
+			vote.Vote = pgtype.Int2{Int16: 1, Valid: true}
+			if entry.Vote.ToValue() == spec.VoteDown.ToValue() {
+				vote.Vote.Int16 = -1
+			}
+		}
+		vote.Rank = int4FromInt(entry.Rank)
+		votes = append(votes, vote)
+	}
+
+	if err := api.store.CastBallot(r.Context(), api.pool, params, participant.ID, votes); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("poll_not_found", "poll not found"))
+		case errors.Is(err, pgstore.ErrPollClosed):
+			return api.problem(w, r, errConflict("poll_closed", "poll is closed"))
+		case errors.Is(err, pgstore.ErrUnknownPollOption):
+			return api.problem(w, r, errNotFound("poll_option_not_found", "poll option not found"))
+		}
+
+		api.logger.Error("failed to cast ballot", zap.Error(err), zap.String("poll_id", pollID), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PutTripsTripIDPollsPollIDVotesParticipantIDJSON204Response(nil)
+}
+
+// Close a poll and apply its winner.
+// (POST /trips/{tripId}/polls/{pollId}/close)
+func (api API) PostTripsTripIDPollsPollIDClose(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *spec.Response {
+	params, ok := api.pollParams(w, r, tripID, pollID)
+	if !ok {
+		return nil
+	}
+
+	if _, err := api.store.ClosePoll(r.Context(), api.pool, params); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return api.problem(w, r, errNotFound("poll_not_found", "poll not found"))
+		case errors.Is(err, pgstore.ErrPollClosed):
+			return api.problem(w, r, errConflict("poll_closed", "poll is closed"))
+		case errors.Is(err, pgstore.ErrPollEmpty):
+			return api.problem(w, r, errConflict("poll_empty", "poll has no options"))
+		case errors.Is(err, pgstore.ErrRouteOutsideTrip):
+			return api.problem(w, r, errRouteOutsideTrip)
+		}
+
+		api.logger.Error("failed to close poll", zap.Error(err), zap.String("poll_id", pollID))
+		return api.problem(w, r, errInternal)
+	}
+
+	poll, ok := api.getPoll(w, r, params)
+	if !ok {
+		return nil
+	}
+
+	return spec.PostTripsTripIDPollsPollIDCloseJSON200Response(spec.GetPollResponse{Poll: poll})
+}
+
+// pollParams parses the ids of a poll route. When it reports false the
+// problem has already been written.
+func (api API) pollParams(w http.ResponseWriter, r *http.Request, tripID, pollID string) (pgstore.LockPollParams, bool) {
+	var params pgstore.LockPollParams
+	var err error
+	if params.TripID, err = uuid.Parse(tripID); err == nil {
+		params.ID, err = uuid.Parse(pollID)
+	}
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.LockPollParams{}, false
+	}
+
+	return params, true
+}
+
+// getPoll loads a poll of a trip with the standing of its options. When it
+// reports false the problem has already been written.
+func (api API) getPoll(w http.ResponseWriter, r *http.Request, params pgstore.LockPollParams) (spec.Poll, bool) {
+	poll, err := api.store.GetPoll(r.Context(), pgstore.GetPollParams(params))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errNotFound("poll_not_found", "poll not found"))
+			return spec.Poll{}, false
+		}
+
+		api.logger.Error("failed to get poll", zap.Error(err), zap.String("poll_id", params.ID.String()))
+		api.problem(w, r, errInternal)
+		return spec.Poll{}, false
+	}
+
+	rows, err := api.store.GetPollOptions(r.Context(), poll.ID)
+	if err != nil {
+		api.logger.Error("failed to get poll options", zap.Error(err), zap.String("poll_id", params.ID.String()))
+		api.problem(w, r, errInternal)
+		return spec.Poll{}, false
+	}
+
+	options := make([]pgstore.GetTripPollOptionsRow, 0, len(rows))
+	for _, row := range rows {
+		options = append(options, pgstore.GetTripPollOptionsRow(row))
+	}
+
+	return pollResponse(poll, options), true
+}
+
+func pollOptionParams(options []spec.PollOptionRequest) []pgstore.PollOptionParams {
+	params := make([]pgstore.PollOptionParams, 0, len(options))
+	for _, option := range options {
+		params = append(params, pgstore.PollOptionParams{
+			Title:    deref(option.Title),
+			OccursAt: timestamp(option.OccursAt),
+			StartsAt: timestamp(option.StartsAt),
+			EndsAt:   timestamp(option.EndsAt),
+		})
+	}
+	return params
+}
+
+func pollResponse(poll pgstore.Poll, options []pgstore.GetTripPollOptionsRow) spec.Poll {
+	response := spec.Poll{
+		ID:       poll.ID.String(),
+		Kind:     pollKind(poll.Kind),
+		Title:    poll.Title,
+		ClosedAt: timeFromTimestamp(poll.ClosedAt),
+		WinnerID: stringFromUUID(poll.WinnerID),
+		Options:  make([]spec.PollOption, 0, len(options)),
+	}
+
+	for _, option := range options {
+		item := spec.PollOption{
+			ID:         option.ID.String(),
+			ActivityID: stringFromUUID(option.ActivityID),
+			OccursAt:   timeFromTimestamp(option.OccursAt),
+			StartsAt:   timeFromTimestamp(option.StartsAt),
+			EndsAt:     timeFromTimestamp(option.EndsAt),
+			Upvotes:    int(option.Upvotes),
+			Downvotes:  int(option.Downvotes),
+			Score:      int(option.Score),
+			RankPoints: int(option.RankPoints),
+		}
+		if option.Title.Valid {
+			item.Title = &option.Title.String
+		}
+		response.Options = append(response.Options, item)
+	}
+
+	return response
+}
+
+// pollKind converts a kind stored in the database. The column is constrained
+// to the values in the spec, so the conversion can't fail.
+func pollKind(kind string) spec.PollKind {
+	var k spec.PollKind
+	_ = k.FromValue(kind)
+	return k
+}
+
+func timestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: *t, Valid: true}
+}
+
+func timeFromTimestamp(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func stringFromUUID(id pgtype.UUID) *string {
+	if !id.Valid {
+		return nil
+	}
+	s := uuid.UUID(id.Bytes).String()
+	return &s
+}
@@ -0,0 +1,332 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/ical"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Book a reservation on a trip.
+// (POST /trips/{tripId}/reservations)
+func (api API) PostTripsTripIDReservations(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.ReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	params := pgstore.CreateReservationParams{
+		TripID:           id,
+		Kind:             body.Kind.ToValue(),
+		Provider:         text(body.Provider),
+		ConfirmationCode: text(body.ConfirmationCode),
+		Number:           text(body.Number),
+		StartsAt:         pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		EndsAt:           pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		StartLocation:    body.StartLocation,
+		EndLocation:      text(body.EndLocation),
+	}
+	params.Cost, params.CostCurrency = estimate(body.Cost)
+
+	reservationID, err := api.store.CreateReservation(r.Context(), params)
+	if err != nil {
+		if pgstore.IsForeignKeyViolation(err) {
+			return api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
+		api.logger.Error("failed to create reservation", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDReservationsJSON201Response(spec.CreateReservationResponse{
+		ReservationID: reservationID.String(),
+	})
+}
+
+// Get a trip reservations.
+// (GET /trips/{tripId}/reservations)
+func (api API) GetTripsTripIDReservations(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	reservations, err := api.store.GetTripReservations(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip reservations", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetReservationsResponse{Reservations: make([]spec.Reservation, 0, len(reservations))}
+	for _, reservation := range reservations {
+		response.Reservations = append(response.Reservations, reservationResponse(reservation))
+	}
+
+	return spec.GetTripsTripIDReservationsJSON200Response(response)
+}
+
+// Get a trip reservation.
+// (GET /trips/{tripId}/reservations/{reservationId})
+func (api API) GetTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params spec.GetTripsTripIDReservationsReservationIDParams) *spec.Response {
+	reservation, ok := api.getReservation(w, r, tripID, reservationID)
+	if !ok {
+		return nil
+	}
+
+	if resp := conditionalGet(w, params.IfNoneMatch, versionETag(reservation.Version)); resp != nil {
+		return resp
+	}
+
+	return spec.GetTripsTripIDReservationsReservationIDJSON200Response(spec.GetReservationResponse{
+		Reservation: reservationResponse(reservation),
+	})
+}
+
+// Update a trip reservation.
+// (PUT /trips/{tripId}/reservations/{reservationId})
+func (api API) PutTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params spec.PutTripsTripIDReservationsReservationIDParams) *spec.Response {
+	var body spec.ReservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	reservation, ok := api.getReservation(w, r, tripID, reservationID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, reservation.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	update := pgstore.UpdateReservationParams{
+		Kind:             body.Kind.ToValue(),
+		Provider:         text(body.Provider),
+		ConfirmationCode: text(body.ConfirmationCode),
+		Number:           text(body.Number),
+		StartsAt:         pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		EndsAt:           pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		StartLocation:    body.StartLocation,
+		EndLocation:      text(body.EndLocation),
+		ID:               reservation.ID,
+		TripID:           reservation.TripID,
+		Version:          reservation.Version,
+	}
+	update.Cost, update.CostCurrency = estimate(body.Cost)
+
+	updated, err := api.store.UpdateReservation(r.Context(), update)
+	if err != nil {
+		api.logger.Error("failed to update reservation", zap.Error(err), zap.String("reservation_id", reservationID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	w.Header().Set("ETag", versionETag(reservation.Version+1))
+	return spec.PutTripsTripIDReservationsReservationIDJSON204Response(nil)
+}
+
+// Delete a trip reservation.
+// (DELETE /trips/{tripId}/reservations/{reservationId})
+func (api API) DeleteTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params spec.DeleteTripsTripIDReservationsReservationIDParams) *spec.Response {
+	reservation, ok := api.getReservation(w, r, tripID, reservationID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, reservation.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	deleted, err := api.store.DeleteReservation(r.Context(), pgstore.DeleteReservationParams{
+		ID:      reservation.ID,
+		TripID:  reservation.TripID,
+		Version: reservation.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete reservation", zap.Error(err), zap.String("reservation_id", reservationID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	return spec.DeleteTripsTripIDReservationsReservationIDJSON204Response(nil)
+}
+
+// Export a trip itinerary as an iCalendar file.
+// (GET /trips/{tripId}/calendar.ics)
+func (api API) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	activities, err := api.store.GetTripActivities(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trips activities", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	reservations, err := api.store.GetTripReservations(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip reservations", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	calendar := ical.Calendar{
+		Name:   "Trip to " + trip.Destination,
+		Stamp:  time.Now(),
+		Events: make([]ical.Event, 0, len(activities)+len(reservations)),
+	}
+	for _, activity := range activities {
+		if !activity.ID.Valid {
+			continue
+		}
+
+		calendar.Events = append(calendar.Events, ical.Event{
+			UID:      "activity-" + uuid.UUID(activity.ID.Bytes).String() + "@plann.er",
+			Summary:  activity.Title.String,
+			Location: trip.Destination,
+			Start:    activity.OccursAt.Time,
+		})
+	}
+	for _, reservation := range reservations {
+		calendar.Events = append(calendar.Events, reservationEvent(reservation))
+	}
+
+	// The calendar is written straight to the client, like problem details,
+	// since the generated responses only render JSON.
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="trip-`+trip.ID.String()+`.ics"`)
+	w.WriteHeader(http.StatusOK)
+	if err := calendar.Write(w); err != nil {
+		api.logger.Error("failed to write calendar", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
+
+// getReservation loads a reservation of a trip. When it reports false the
+// problem has already been written.
+func (api API) getReservation(w http.ResponseWriter, r *http.Request, tripID, reservationID string) (pgstore.Reservation, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Reservation{}, false
+	}
+
+	rid, err := uuid.Parse(reservationID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Reservation{}, false
+	}
+
+	reservation, err := api.store.GetReservation(r.Context(), pgstore.GetReservationParams{ID: rid, TripID: tid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errNotFound("reservation_not_found", "reservation not found"))
+			return pgstore.Reservation{}, false
+		}
+
+		api.logger.Error("failed to get reservation", zap.Error(err), zap.String("reservation_id", reservationID))
+		api.problem(w, r, errInternal)
+		return pgstore.Reservation{}, false
+	}
+
+	return reservation, true
+}
+
+func reservationResponse(reservation pgstore.Reservation) spec.Reservation {
+	var kind spec.ReservationKind
+	_ = kind.FromValue(reservation.Kind)
+
+	return spec.Reservation{
+		ID:               reservation.ID.String(),
+		Kind:             kind,
+		Title:            reservationTitle(reservation),
+		Provider:         stringFromText(reservation.Provider),
+		ConfirmationCode: stringFromText(reservation.ConfirmationCode),
+		Number:           stringFromText(reservation.Number),
+		StartsAt:         reservation.StartsAt.Time,
+		EndsAt:           reservation.EndsAt.Time,
+		StartLocation:    reservation.StartLocation,
+		EndLocation:      stringFromText(reservation.EndLocation),
+		Cost:             moneyResponse(reservation.Cost, reservation.CostCurrency),
+	}
+}
+
+// reservationTitle sums a reservation up in a line, e.g. "Flight LH 400
+// FRA → JFK" or "Stay at Hotel Adlon".
+func reservationTitle(reservation pgstore.Reservation) string {
+	switch reservation.Kind {
+	case spec.ReservationKindLodging.ToValue():
+		if reservation.Provider.Valid {
+			return "Stay at " + reservation.Provider.String
+		}
+		return "Stay at " + reservation.StartLocation
+
+	case spec.ReservationKindFlight.ToValue(), spec.ReservationKindTrain.ToValue():
+		title := "Flight"
+		if reservation.Kind == spec.ReservationKindTrain.ToValue() {
+			title = "Train"
+		}
+		if reservation.Number.Valid {
+			title += " " + reservation.Number.String
+		}
+		return title + " " + reservation.StartLocation + " → " + reservation.EndLocation.String
+
+	default:
+		if reservation.Provider.Valid {
+			return "Car rental from " + reservation.Provider.String
+		}
+		return "Car rental"
+	}
+}
+
+// reservationEvent lays a reservation out as a calendar event spanning from
+// check-in, departure or pick-up to check-out, arrival or drop-off.
+func reservationEvent(reservation pgstore.Reservation) ical.Event {
+	var details []string
+	if reservation.Provider.Valid {
+		details = append(details, "Provider: "+reservation.Provider.String)
+	}
+	if reservation.ConfirmationCode.Valid {
+		details = append(details, "Confirmation code: "+reservation.ConfirmationCode.String)
+	}
+	if reservation.EndLocation.Valid {
+		label := "Arrival: "
+		if reservation.Kind == spec.ReservationKindCarRental.ToValue() {
+			label = "Drop-off: "
+		}
+		details = append(details, label+reservation.EndLocation.String)
+	}
+
+	return ical.Event{
+		UID:         "reservation-" + reservation.ID.String() + "@plann.er",
+		Summary:     reservationTitle(reservation),
+		Description: strings.Join(details, "\n"),
+		Location:    reservation.StartLocation,
+		Start:       reservation.StartsAt.Time,
+		End:         reservation.EndsAt.Time,
+	}
+}
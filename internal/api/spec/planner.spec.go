@@ -23,8 +23,373 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for BulkInviteResultStatus.
+var (
+	UnknownBulkInviteResultStatus = BulkInviteResultStatus{}
+
+	BulkInviteResultStatusCreated = BulkInviteResultStatus{"created"}
+
+	BulkInviteResultStatusDuplicate = BulkInviteResultStatus{"duplicate"}
+
+	BulkInviteResultStatusInvalid = BulkInviteResultStatus{"invalid"}
+)
+
+// Defines values for ChatPlatform.
+var (
+	UnknownChatPlatform = ChatPlatform{}
+
+	ChatPlatformDiscord = ChatPlatform{"discord"}
+
+	ChatPlatformSlack = ChatPlatform{"slack"}
+)
+
+// Defines values for ExpenseCategory.
+var (
+	UnknownExpenseCategory = ExpenseCategory{}
+
+	ExpenseCategoryActivities = ExpenseCategory{"activities"}
+
+	ExpenseCategoryFood = ExpenseCategory{"food"}
+
+	ExpenseCategoryLodging = ExpenseCategory{"lodging"}
+
+	ExpenseCategoryOther = ExpenseCategory{"other"}
+
+	ExpenseCategoryTransport = ExpenseCategory{"transport"}
+)
+
+// Defines values for ParticipantRole.
+var (
+	UnknownParticipantRole = ParticipantRole{}
+
+	ParticipantRoleEditor = ParticipantRole{"editor"}
+
+	ParticipantRoleViewer = ParticipantRole{"viewer"}
+)
+
+// Defines values for PollKind.
+var (
+	UnknownPollKind = PollKind{}
+
+	PollKindActivity = PollKind{"activity"}
+
+	PollKindDates = PollKind{"dates"}
+)
+
+// Defines values for ReservationKind.
+var (
+	UnknownReservationKind = ReservationKind{}
+
+	ReservationKindCarRental = ReservationKind{"car_rental"}
+
+	ReservationKindFlight = ReservationKind{"flight"}
+
+	ReservationKindLodging = ReservationKind{"lodging"}
+
+	ReservationKindTrain = ReservationKind{"train"}
+)
+
+// Defines values for SplitMode.
+var (
+	UnknownSplitMode = SplitMode{}
+
+	SplitModeEqual = SplitMode{"equal"}
+
+	SplitModeExact = SplitMode{"exact"}
+
+	SplitModeShares = SplitMode{"shares"}
+)
+
+// Defines values for TaskStatus.
+var (
+	UnknownTaskStatus = TaskStatus{}
+
+	TaskStatusDone = TaskStatus{"done"}
+
+	TaskStatusInProgress = TaskStatus{"in_progress"}
+
+	TaskStatusTodo = TaskStatus{"todo"}
+)
+
+// Defines values for TripEventType.
+var (
+	UnknownTripEventType = TripEventType{}
+
+	TripEventTypeActivityCreated = TripEventType{"activity.created"}
+
+	TripEventTypeActivityDeleted = TripEventType{"activity.deleted"}
+
+	TripEventTypeActivityUpdated = TripEventType{"activity.updated"}
+
+	TripEventTypeLinkCreated = TripEventType{"link.created"}
+
+	TripEventTypeLinkDeleted = TripEventType{"link.deleted"}
+
+	TripEventTypeLinkUpdated = TripEventType{"link.updated"}
+
+	TripEventTypeParticipantCreated = TripEventType{"participant.created"}
+
+	TripEventTypeParticipantDeleted = TripEventType{"participant.deleted"}
+
+	TripEventTypeParticipantUpdated = TripEventType{"participant.updated"}
+
+	TripEventTypeTripUpdated = TripEventType{"trip.updated"}
+)
+
+// Defines values for Vote.
+var (
+	UnknownVote = Vote{}
+
+	VoteDown = Vote{"down"}
+
+	VoteUp = Vote{"up"}
+)
+
+// Defines values for WebhookDeliveryStatus.
+var (
+	UnknownWebhookDeliveryStatus = WebhookDeliveryStatus{}
+
+	WebhookDeliveryStatusFailed = WebhookDeliveryStatus{"failed"}
+
+	WebhookDeliveryStatusPending = WebhookDeliveryStatus{"pending"}
+
+	WebhookDeliveryStatusSucceeded = WebhookDeliveryStatus{"succeeded"}
+)
+
+// ActivityAttendee defines model for ActivityAttendee.
+type ActivityAttendee struct {
+	Email openapi_types.Email `json:"email"`
+	ID    string              `json:"id"`
+	Name  string              `json:"name"`
+}
+
+// AddPollOptionsRequest defines model for AddPollOptionsRequest.
+type AddPollOptionsRequest struct {
+	Options []PollOptionRequest `json:"options" validate:"required,min=1,max=20,dive"`
+}
+
+// AddPollOptionsResponse defines model for AddPollOptionsResponse.
+type AddPollOptionsResponse struct {
+	OptionIds []string `json:"optionIds"`
+}
+
+// A vote, a rank or both for one option. Rank 1 is the favourite.
+type BallotEntry struct {
+	OptionID string `json:"option_id" validate:"required,uuid"`
+	Rank     *int   `json:"rank,omitempty" validate:"omitempty,min=1"`
+	Vote     *Vote  `json:"vote,omitempty"`
+}
+
+// BallotRequest defines model for BallotRequest.
+type BallotRequest struct {
+	// Replaces the participant's previous votes on the poll. An empty list withdraws them.
+	Votes []BallotEntry `json:"votes" validate:"required,unique=OptionID,dive"`
+}
+
+// BudgetLine defines model for BudgetLine.
+type BudgetLine struct {
+	// Sum of the expenses logged so far.
+	Actual         string `json:"actual"`
+	AlertThreshold *int   `json:"alert_threshold"`
+
+	// Null when no budget is set for the category.
+	Budget *string `json:"budget"`
+
+	// What an expense was for. Expenses tied to an activity default to activities, the others to other.
+	Category ExpenseCategory `json:"category"`
+
+	// Estimated cost of the scheduled activities and of the reservations.
+	Planned string `json:"planned"`
+
+	// Budget minus actual spend, negative once over budget.
+	Remaining *string `json:"remaining"`
+}
+
+// BudgetRequest defines model for BudgetRequest.
+type BudgetRequest struct {
+	// Percentage of the limit that triggers an email to the owner. Defaults to 80.
+	AlertThreshold *int `json:"alert_threshold,omitempty" validate:"omitempty,min=1,max=100"`
+
+	// Limit in the trip's home currency.
+	Amount string `json:"amount" validate:"required,amount"`
+}
+
+// BudgetTotals defines model for BudgetTotals.
+type BudgetTotals struct {
+	Actual    string  `json:"actual"`
+	Budget    *string `json:"budget"`
+	Planned   string  `json:"planned"`
+	Remaining *string `json:"remaining"`
+}
+
+// BulkInviteRequest defines model for BulkInviteRequest.
+type BulkInviteRequest []BulkInviteRow
+
+// BulkInviteResponse defines model for BulkInviteResponse.
+type BulkInviteResponse struct {
+	Created   int                `json:"created"`
+	Duplicate int                `json:"duplicate"`
+	Invalid   int                `json:"invalid"`
+	Rows      []BulkInviteResult `json:"rows"`
+}
+
+// BulkInviteResult defines model for BulkInviteResult.
+type BulkInviteResult struct {
+	Email  string  `json:"email"`
+	Name   *string `json:"name,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+
+	// 1-based position of the row in the upload, not counting a CSV header.
+	Row    int                    `json:"row"`
+	Status BulkInviteResultStatus `json:"status"`
+}
+
+// BulkInviteRow defines model for BulkInviteRow.
+type BulkInviteRow struct {
+	Email string  `json:"email"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// ChatChannel defines model for ChatChannel.
+type ChatChannel struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Set once the platform said the incoming webhook is gone.
+	Disabled bool `json:"disabled"`
+
+	// Event types posted, every type when empty.
+	Events []TripEventType `json:"events"`
+	ID     string          `json:"id"`
+
+	// Why the last post failed, null once a post succeeds.
+	LastError *string `json:"last_error"`
+	Name      string  `json:"name"`
+
+	// Format of the incoming webhook, platforms compatible with Slack or Discord webhooks work as well.
+	Platform ChatPlatform `json:"platform"`
+}
+
+// ChatChannelRequest defines model for ChatChannelRequest.
+type ChatChannelRequest struct {
+	// Event types to post, every type when left out or empty.
+	Events []TripEventType `json:"events,omitempty"`
+
+	// Label telling the channels apart, e.g. #lisbon-trip.
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Format of the incoming webhook, platforms compatible with Slack or Discord webhooks work as well.
+	Platform ChatPlatform `json:"platform"`
+
+	// The incoming webhook URL. It must resolve to a public address.
+	URL string `json:"url" validate:"required,url,max=2048,urlscheme=http https"`
+}
+
+// Checklist defines model for Checklist.
+type Checklist struct {
+	ID string `json:"id"`
+
+	// Items in their checklist order.
+	Items []ChecklistItem `json:"items"`
+
+	// Owner of a personal checklist, null when the checklist is shared.
+	ParticipantID *string `json:"participant_id"`
+	Title         string  `json:"title"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	AssigneeID *string    `json:"assignee_id"`
+	Checked    bool       `json:"checked"`
+	CheckedAt  *time.Time `json:"checked_at"`
+	ID         string     `json:"id"`
+	Text       string     `json:"text"`
+}
+
+// ChecklistItemRequest defines model for ChecklistItemRequest.
+type ChecklistItemRequest struct {
+	// Participant in charge of the item.
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Text       string  `json:"text" validate:"required,max=255"`
+}
+
+// ChecklistOrderRequest defines model for ChecklistOrderRequest.
+type ChecklistOrderRequest struct {
+	// Every item of the checklist, in the new order.
+	ItemIds []string `json:"item_ids" validate:"required,dive,uuid"`
+}
+
+// ChecklistRequest defines model for ChecklistRequest.
+type ChecklistRequest struct {
+	// Participant a personal checklist belongs to. Leave it out for a checklist shared by the whole trip.
+	ParticipantID *string `json:"participant_id,omitempty" validate:"omitempty,uuid"`
+
+	// Template to copy the items from, one of the templates saved by the trip owner.
+	TemplateID *string `json:"template_id,omitempty" validate:"omitempty,uuid"`
+	Title      string  `json:"title" validate:"required,max=255"`
+}
+
+// ChecklistTemplate defines model for ChecklistTemplate.
+type ChecklistTemplate struct {
+	ID    string   `json:"id"`
+	Items []string `json:"items"`
+	Title string   `json:"title"`
+}
+
+// ChecklistTemplateRequest defines model for ChecklistTemplateRequest.
+type ChecklistTemplateRequest struct {
+	// Defaults to the title of the checklist.
+	Title *string `json:"title,omitempty" validate:"omitempty,max=255"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	ActivityID *string `json:"activity_id"`
+
+	// Null once the author left the trip.
+	AuthorID *string `json:"author_id"`
+
+	// Null once the comment is deleted.
+	Body      *string   `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Deleted comments stay listed while they have replies, so threads keep their shape.
+	Deleted  bool       `json:"deleted"`
+	EditedAt *time.Time `json:"edited_at"`
+	ID       string     `json:"id"`
+	LinkID   *string    `json:"link_id"`
+
+	// Participants mentioned in the comment.
+	Mentions   []string `json:"mentions"`
+	ParentID   *string  `json:"parent_id"`
+	ReplyCount int      `json:"reply_count"`
+}
+
+// CommentRequest defines model for CommentRequest.
+type CommentRequest struct {
+	// Activity the comment is about. Leave out both activity_id and link_id to comment on the trip itself.
+	ActivityID *string `json:"activity_id,omitempty" validate:"omitempty,uuid,excluded_with=LinkID"`
+
+	// Participant writing the comment.
+	AuthorID string `json:"author_id" validate:"required,uuid"`
+
+	// Mention participants with @ followed by their email, e.g. @ana@example.com. Mentioned participants are notified by email.
+	Body string `json:"body" validate:"required,max=4000"`
+
+	// Link the comment is about.
+	LinkID *string `json:"link_id,omitempty" validate:"omitempty,uuid"`
+
+	// Comment being replied to. Replies are about the same trip, activity or link as their parent.
+	ParentID *string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
+	// Maximum number of attendees. Leave it out for no limit.
+	Capacity      *int   `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	EstimatedCost *Money `json:"estimated_cost,omitempty"`
+
+	// Leg of the route the activity takes place on. It must occur during the leg, in the leg's time zone.
+	LegID    *string   `json:"leg_id,omitempty" validate:"omitempty,uuid"`
 	OccursAt time.Time `json:"occurs_at" validate:"required"`
 	Title    string    `json:"title" validate:"required"`
 }
@@ -34,10 +399,76 @@ type CreateActivityResponse struct {
 	ActivityID string `json:"activityId"`
 }
 
+// CreateChatChannelResponse defines model for CreateChatChannelResponse.
+type CreateChatChannelResponse struct {
+	ChannelID string `json:"channelId"`
+}
+
+// CreateChecklistItemResponse defines model for CreateChecklistItemResponse.
+type CreateChecklistItemResponse struct {
+	ItemID string `json:"itemId"`
+}
+
+// CreateChecklistResponse defines model for CreateChecklistResponse.
+type CreateChecklistResponse struct {
+	ChecklistID string `json:"checklistId"`
+}
+
+// CreateChecklistTemplateResponse defines model for CreateChecklistTemplateResponse.
+type CreateChecklistTemplateResponse struct {
+	TemplateID string `json:"templateId"`
+}
+
+// CreateCommentResponse defines model for CreateCommentResponse.
+type CreateCommentResponse struct {
+	CommentID string `json:"commentId"`
+}
+
+// CreateExpenseRequest defines model for CreateExpenseRequest.
+type CreateExpenseRequest struct {
+	ActivityID *string `json:"activity_id,omitempty" validate:"omitempty,uuid"`
+	Amount     string  `json:"amount" validate:"required,amount"`
+
+	// What an expense was for. Expenses tied to an activity default to activities, the others to other.
+	Category *ExpenseCategory `json:"category,omitempty"`
+
+	// ISO 4217 currency code.
+	Currency    string `json:"currency" validate:"required,currency"`
+	Description string `json:"description" validate:"required,max=255"`
+	PayerID     string `json:"payer_id" validate:"required,uuid"`
+
+	// Defaults to now.
+	SpentAt *time.Time `json:"spent_at,omitempty"`
+
+	// How an expense is divided: evenly, in proportion to shares, or by exact amounts that add up to the expense.
+	SplitMode SplitMode             `json:"split_mode"`
+	Splits    []ExpenseSplitRequest `json:"splits" validate:"required,min=1,max=100,unique=ParticipantID,dive"`
+}
+
+// CreateExpenseResponse defines model for CreateExpenseResponse.
+type CreateExpenseResponse struct {
+	ExpenseID string `json:"expenseId"`
+}
+
+// CreateJoinLinkRequest defines model for CreateJoinLinkRequest.
+type CreateJoinLinkRequest struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty" validate:"omitempty,future"`
+	MaxUses   *int       `json:"max_uses,omitempty" validate:"omitempty,min=1"`
+
+	// What a participant may do on the trip. Editors can change the plan, viewers can only read it.
+	Role *ParticipantRole `json:"role,omitempty"`
+}
+
+// CreateJoinLinkResponse defines model for CreateJoinLinkResponse.
+type CreateJoinLinkResponse struct {
+	Code       string `json:"code"`
+	JoinLinkID string `json:"joinLinkId"`
+}
+
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
 	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
+	URL   string `json:"url" validate:"required,url,urlscheme=http https"`
 }
 
 // CreateLinkResponse defines model for CreateLinkResponse.
@@ -45,14 +476,47 @@ type CreateLinkResponse struct {
 	LinkID string `json:"linkId"`
 }
 
+// CreatePollRequest defines model for CreatePollRequest.
+type CreatePollRequest struct {
+	// What a poll decides: which proposed activity makes it into the itinerary, or which dates the trip takes place on.
+	Kind    PollKind            `json:"kind"`
+	Options []PollOptionRequest `json:"options" validate:"required,min=1,max=20,dive"`
+	Title   string              `json:"title" validate:"required,max=255"`
+}
+
+// CreatePollResponse defines model for CreatePollResponse.
+type CreatePollResponse struct {
+	PollID string `json:"pollId"`
+}
+
+// CreateReservationResponse defines model for CreateReservationResponse.
+type CreateReservationResponse struct {
+	ReservationID string `json:"reservationId"`
+}
+
+// CreateTaskCommentResponse defines model for CreateTaskCommentResponse.
+type CreateTaskCommentResponse struct {
+	CommentID string `json:"commentId"`
+}
+
+// CreateTaskResponse defines model for CreateTaskResponse.
+type CreateTaskResponse struct {
+	TaskID string `json:"taskId"`
+}
+
 // CreateTripRequest defines model for CreateTripRequest.
 type CreateTripRequest struct {
+	// Maximum number of confirmed participants, not counting the owner. Leave it out for no limit.
+	Capacity       *int                  `json:"capacity,omitempty" validate:"omitempty,min=1"`
 	Destination    string                `json:"destination" validate:"required,min=4"`
-	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,max=50,unique_emails,dive,email"`
+	EndsAt         time.Time             `json:"ends_at" validate:"required,gtfield=StartsAt"`
+
+	// ISO 4217 code that expense totals are converted to. Leave it out to keep totals per currency.
+	HomeCurrency *string             `json:"home_currency,omitempty" validate:"omitempty,currency"`
+	OwnerEmail   openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName    string              `json:"owner_name" validate:"required"`
+	StartsAt     time.Time           `json:"starts_at" validate:"required,future"`
 }
 
 // CreateTripResponse defines model for CreateTripResponse.
@@ -60,9 +524,178 @@ type CreateTripResponse struct {
 	TripID string `json:"tripId"`
 }
 
-// Bad request
-type Error struct {
-	Message string `json:"message"`
+// CreateWebhookResponse defines model for CreateWebhookResponse.
+type CreateWebhookResponse struct {
+	// Key of the HMAC-SHA256 signatures. It is only shown once.
+	Secret    string `json:"secret"`
+	WebhookID string `json:"webhookId"`
+}
+
+// CurrencyBalances defines model for CurrencyBalances.
+type CurrencyBalances struct {
+	Balances []ParticipantBalance `json:"balances"`
+	Currency string               `json:"currency"`
+
+	// Sum of the expenses in this currency.
+	Total string `json:"total"`
+
+	// Payments that settle every balance, at most one fewer than the participants with a non-zero balance.
+	Transfers []SettleUpTransfer `json:"transfers"`
+}
+
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	Base        string             `json:"base"`
+	EffectiveOn openapi_types.Date `json:"effective_on"`
+	Quote       string             `json:"quote"`
+
+	// Price of one base in quote.
+	Rate string `json:"rate"`
+
+	// upload, or the name of the provider the rate came from.
+	Source string `json:"source"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// ISO 4217 currency code.
+	Base string `json:"base" validate:"required,currency"`
+
+	// First day the rate applies to. It holds until a later rate for the same pair.
+	EffectiveOn openapi_types.Date `json:"effective_on" validate:"required"`
+
+	// ISO 4217 currency code.
+	Quote string `json:"quote" validate:"required,currency,nefield=Base"`
+
+	// Price of one base in quote.
+	Rate string `json:"rate" validate:"required,rate"`
+}
+
+// ExchangeRatesUpload defines model for ExchangeRatesUpload.
+type ExchangeRatesUpload struct {
+	Rates []ExchangeRateRequest `json:"rates" validate:"required,dive"`
+}
+
+// Expense defines model for Expense.
+type Expense struct {
+	ActivityID *string `json:"activity_id"`
+	Amount     string  `json:"amount"`
+
+	// What an expense was for. Expenses tied to an activity default to activities, the others to other.
+	Category    ExpenseCategory `json:"category"`
+	Currency    string          `json:"currency"`
+	Description string          `json:"description"`
+	ID          string          `json:"id"`
+	PayerID     string          `json:"payer_id"`
+	SpentAt     time.Time       `json:"spent_at"`
+
+	// How an expense is divided: evenly, in proportion to shares, or by exact amounts that add up to the expense.
+	SplitMode SplitMode      `json:"split_mode"`
+	Splits    []ExpenseSplit `json:"splits"`
+}
+
+// ExpenseSplit defines model for ExpenseSplit.
+type ExpenseSplit struct {
+	Amount        string `json:"amount"`
+	ParticipantID string `json:"participant_id"`
+	Shares        *int   `json:"shares"`
+}
+
+// ExpenseSplitRequest defines model for ExpenseSplitRequest.
+type ExpenseSplitRequest struct {
+	// Required when splitting by exact amounts.
+	Amount        *string `json:"amount,omitempty" validate:"omitempty,amount"`
+	ParticipantID string  `json:"participant_id" validate:"required,uuid"`
+
+	// Required when splitting by shares.
+	Shares *int `json:"shares,omitempty" validate:"omitempty,min=1,max=1000"`
+}
+
+// GetActivityResponse defines model for GetActivityResponse.
+type GetActivityResponse struct {
+	Activity GetTripActivitiesResponseInnerArray `json:"activity"`
+}
+
+// Balances per currency. When the trip has a home currency there is a single entry in it, every expense being converted at the rate of the day it was spent.
+type GetBalancesResponse struct {
+	Currencies []CurrencyBalances `json:"currencies"`
+}
+
+// GetBudgetResponse defines model for GetBudgetResponse.
+type GetBudgetResponse struct {
+	Categories []BudgetLine `json:"categories"`
+
+	// The trip's home currency, which every amount is converted to.
+	Currency string       `json:"currency"`
+	Total    BudgetTotals `json:"total"`
+}
+
+// GetChatChannelsResponse defines model for GetChatChannelsResponse.
+type GetChatChannelsResponse struct {
+	Channels []ChatChannel `json:"channels"`
+}
+
+// GetChecklistResponse defines model for GetChecklistResponse.
+type GetChecklistResponse struct {
+	Checklist Checklist `json:"checklist"`
+}
+
+// GetChecklistTemplatesResponse defines model for GetChecklistTemplatesResponse.
+type GetChecklistTemplatesResponse struct {
+	Templates []ChecklistTemplate `json:"templates"`
+}
+
+// GetChecklistsResponse defines model for GetChecklistsResponse.
+type GetChecklistsResponse struct {
+	Checklists []Checklist `json:"checklists"`
+}
+
+// GetCommentsResponse defines model for GetCommentsResponse.
+type GetCommentsResponse struct {
+	Comments []Comment `json:"comments"`
+
+	// Pass as cursor to get the next page, null on the last page.
+	NextCursor *string `json:"next_cursor"`
+}
+
+// GetExchangeRatesResponse defines model for GetExchangeRatesResponse.
+type GetExchangeRatesResponse struct {
+	On    openapi_types.Date `json:"on"`
+	Rates []ExchangeRate     `json:"rates"`
+}
+
+// GetExpenseResponse defines model for GetExpenseResponse.
+type GetExpenseResponse struct {
+	Expense Expense `json:"expense"`
+}
+
+// GetExpensesResponse defines model for GetExpensesResponse.
+type GetExpensesResponse struct {
+	Expenses []Expense `json:"expenses"`
+}
+
+// GetJoinLinksResponse defines model for GetJoinLinksResponse.
+type GetJoinLinksResponse struct {
+	JoinLinks []GetJoinLinksResponseArray `json:"join_links"`
+}
+
+// GetJoinLinksResponseArray defines model for GetJoinLinksResponseArray.
+type GetJoinLinksResponseArray struct {
+	Code      string     `json:"code"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	ID        string     `json:"id"`
+	MaxUses   *int       `json:"max_uses"`
+	RevokedAt *time.Time `json:"revoked_at"`
+
+	// What a participant may do on the trip. Editors can change the plan, viewers can only read it.
+	Role ParticipantRole `json:"role"`
+	Uses int             `json:"uses"`
+}
+
+// GetLinkResponse defines model for GetLinkResponse.
+type GetLinkResponse struct {
+	Link GetLinksResponseArray `json:"link"`
 }
 
 // GetLinksResponse defines model for GetLinksResponse.
@@ -77,6 +710,38 @@ type GetLinksResponseArray struct {
 	URL   string `json:"url"`
 }
 
+// GetPollResponse defines model for GetPollResponse.
+type GetPollResponse struct {
+	Poll Poll `json:"poll"`
+}
+
+// GetPollsResponse defines model for GetPollsResponse.
+type GetPollsResponse struct {
+	Polls []Poll `json:"polls"`
+}
+
+// GetReservationResponse defines model for GetReservationResponse.
+type GetReservationResponse struct {
+	Reservation Reservation `json:"reservation"`
+}
+
+// GetReservationsResponse defines model for GetReservationsResponse.
+type GetReservationsResponse struct {
+	Reservations []Reservation `json:"reservations"`
+}
+
+// GetTaskResponse defines model for GetTaskResponse.
+type GetTaskResponse struct {
+	// Comments from the oldest.
+	Comments []TaskComment `json:"comments"`
+	Task     Task          `json:"task"`
+}
+
+// GetTasksResponse defines model for GetTasksResponse.
+type GetTasksResponse struct {
+	Tasks []Task `json:"tasks"`
+}
+
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
 	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
@@ -84,15 +749,22 @@ type GetTripActivitiesResponse struct {
 
 // GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
 type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+	Attendees     []ActivityAttendee `json:"attendees"`
+	Capacity      *int               `json:"capacity"`
+	EstimatedCost *Money             `json:"estimated_cost,omitempty"`
+	ID            string             `json:"id"`
+	LegID         *string            `json:"leg_id"`
+	OccursAt      time.Time          `json:"occurs_at"`
+	Title         string             `json:"title"`
 }
 
 // GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
 type GetTripActivitiesResponseOuterArray struct {
 	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
 	Date       time.Time                             `json:"date"`
+
+	// Reservations starting on the date.
+	Reservations []Reservation `json:"reservations"`
 }
 
 // GetTripDetailsResponse defines model for GetTripDetailsResponse.
@@ -102,11 +774,33 @@ type GetTripDetailsResponse struct {
 
 // GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
 type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+	Capacity     *int      `json:"capacity"`
+	Destination  string    `json:"destination"`
+	EndsAt       time.Time `json:"ends_at"`
+	HomeCurrency *string   `json:"home_currency"`
+	ID           string    `json:"id"`
+	IsConfirmed  bool      `json:"is_confirmed"`
+
+	// Legs of the trip in travel order, empty for a single destination trip.
+	Route    []TripLeg `json:"route"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// GetTripOverviewResponse defines model for GetTripOverviewResponse.
+type GetTripOverviewResponse struct {
+	Activities   []GetTripActivitiesResponseOuterArray `json:"activities"`
+	Links        []GetLinksResponseArray               `json:"links"`
+	Participants []GetTripParticipantsResponseArray    `json:"participants"`
+	Summary      GetTripOverviewResponseSummary        `json:"summary"`
+	Trip         GetTripDetailsResponseTripObj         `json:"trip"`
+}
+
+// GetTripOverviewResponseSummary defines model for GetTripOverviewResponseSummary.
+type GetTripOverviewResponseSummary struct {
+	Activities            int `json:"activities"`
+	ConfirmedParticipants int `json:"confirmed_participants"`
+	Links                 int `json:"links"`
+	Participants          int `json:"participants"`
 }
 
 // GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
@@ -120,6 +814,22 @@ type GetTripParticipantsResponseArray struct {
 	ID          string              `json:"id"`
 	IsConfirmed bool                `json:"is_confirmed"`
 	Name        *string             `json:"name"`
+
+	// What a participant may do on the trip. Editors can change the plan, viewers can only read it.
+	Role ParticipantRole `json:"role"`
+
+	// 1-based place on the waitlist, null when not waitlisted.
+	WaitlistPosition *int `json:"waitlist_position"`
+}
+
+// GetWebhookDeliveriesResponse defines model for GetWebhookDeliveriesResponse.
+type GetWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// GetWebhooksResponse defines model for GetWebhooksResponse.
+type GetWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
 }
 
 // InviteParticipantRequest defines model for InviteParticipantRequest.
@@ -127,386 +837,5563 @@ type InviteParticipantRequest struct {
 	Email openapi_types.Email `json:"email" validate:"required,email"`
 }
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+// JoinTripRequest defines model for JoinTripRequest.
+type JoinTripRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+	Name  *string             `json:"name,omitempty"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// JoinTripResponse defines model for JoinTripResponse.
+type JoinTripResponse struct {
+	ParticipantID string `json:"participantId"`
+	TripID        string `json:"tripId"`
+}
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+// Money defines model for Money.
+type Money struct {
+	Amount string `json:"amount" validate:"required,amount"`
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+	// ISO 4217 currency code.
+	Currency string `json:"currency" validate:"required,currency"`
+}
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+// ParticipantBalance defines model for ParticipantBalance.
+type ParticipantBalance struct {
+	Name string `json:"name"`
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+	// Paid minus owed. Positive when the participant is owed money.
+	Net           string `json:"net"`
+	Owed          string `json:"owed"`
+	Paid          string `json:"paid"`
+	ParticipantID string `json:"participant_id"`
+}
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+// A JSON Merge Patch (RFC 7386) applied to the trip. Omitted fields are left untouched.
+type PatchTripRequest struct {
+	// Set to null to remove the limit.
+	Capacity    *int       `json:"capacity"`
+	Destination *string    `json:"destination,omitempty"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// Set to null to stop converting totals. It can't change while the trip has budgets.
+	HomeCurrency *string    `json:"home_currency"`
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// Poll defines model for Poll.
+type Poll struct {
+	ClosedAt *time.Time `json:"closed_at"`
+	ID       string     `json:"id"`
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// What a poll decides: which proposed activity makes it into the itinerary, or which dates the trip takes place on.
+	Kind PollKind `json:"kind"`
+
+	// Ordered by standing, the leading option first.
+	Options []PollOption `json:"options"`
+	Title   string       `json:"title"`
+
+	// The winning option, set once the poll is closed.
+	WinnerID *string `json:"winner_id"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+// PollOption defines model for PollOption.
+type PollOption struct {
+	ActivityID *string    `json:"activity_id"`
+	Downvotes  int        `json:"downvotes"`
+	EndsAt     *time.Time `json:"ends_at"`
+	ID         string     `json:"id"`
+	OccursAt   *time.Time `json:"occurs_at"`
+
+	// Borda count of the rankings: the first of n options earns n points, the last one 1. Breaks ties on score.
+	RankPoints int `json:"rank_points"`
+
+	// Upvotes minus downvotes.
+	Score    int        `json:"score"`
+	StartsAt *time.Time `json:"starts_at"`
+	Title    *string    `json:"title"`
+	Upvotes  int        `json:"upvotes"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// An activity poll option needs a title and occurs_at, a dates poll option needs starts_at and ends_at.
+type PollOptionRequest struct {
+	EndsAt   *time.Time `json:"ends_at,omitempty" validate:"omitempty,gtfield=StartsAt"`
+	OccursAt *time.Time `json:"occurs_at,omitempty"`
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	Title    *string    `json:"title,omitempty" validate:"omitempty,max=255"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// Problem details as described in RFC 7807.
+type Problem struct {
+	Code      string              `json:"code"`
+	Detail    *string             `json:"detail,omitempty"`
+	Errors    []ProblemFieldError `json:"errors,omitempty"`
+	Instance  *string             `json:"instance,omitempty"`
+	RequestID *string             `json:"request_id,omitempty"`
+	Status    int                 `json:"status"`
+	Title     string              `json:"title"`
+	Type      string              `json:"type"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ProblemFieldError defines model for ProblemFieldError.
+type ProblemFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Rule    string `json:"rule"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// RedeliverWebhookResponse defines model for RedeliverWebhookResponse.
+type RedeliverWebhookResponse struct {
+	DeliveryID string `json:"deliveryId"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// Reservation defines model for Reservation.
+type Reservation struct {
+	ConfirmationCode *string   `json:"confirmation_code"`
+	Cost             *Money    `json:"cost,omitempty"`
+	EndLocation      *string   `json:"end_location"`
+	EndsAt           time.Time `json:"ends_at"`
+	ID               string    `json:"id"`
+
+	// What a reservation books: a place to stay, a flight, a train ride or a rental car.
+	Kind          ReservationKind `json:"kind"`
+	Number        *string         `json:"number"`
+	Provider      *string         `json:"provider"`
+	StartLocation string          `json:"start_location"`
+	StartsAt      time.Time       `json:"starts_at"`
+
+	// Summary of the reservation, as shown in calendars.
+	Title string `json:"title"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// ReservationRequest defines model for ReservationRequest.
+type ReservationRequest struct {
+	ConfirmationCode *string `json:"confirmation_code,omitempty" validate:"omitempty,max=64"`
+	Cost             *Money  `json:"cost,omitempty"`
+
+	// Arrival airport or station, required for flights and trains. Drop-off location of a rental car when it differs from the pick-up. Lodgings have none.
+	EndLocation *string `json:"end_location,omitempty" validate:"omitempty,max=255"`
+
+	// Check-out, arrival or drop-off.
+	EndsAt time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+
+	// What a reservation books: a place to stay, a flight, a train ride or a rental car.
+	Kind ReservationKind `json:"kind"`
+
+	// Flight or train number. Only flights and trains have one.
+	Number *string `json:"number,omitempty" validate:"omitempty,max=32"`
+
+	// Hotel, airline, rail operator or rental company.
+	Provider *string `json:"provider,omitempty" validate:"omitempty,max=255"`
+
+	// Address of the lodging, departure airport or station, or pick-up location.
+	StartLocation string `json:"start_location" validate:"required,max=255"`
+
+	// Check-in of a lodging, departure of a flight or train, pick-up of a rental car.
+	StartsAt time.Time `json:"starts_at" validate:"required"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// RouteRequest defines model for RouteRequest.
+type RouteRequest struct {
+	// Legs in travel order. Each leg starts on the day the previous one ends, and the route stays within the trip dates. An empty list clears the route.
+	Legs []TripLegRequest `json:"legs" validate:"required,max=20,dive"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// RouteResponse defines model for RouteResponse.
+type RouteResponse struct {
+	Route []TripLeg `json:"route"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// SaveExchangeRatesResponse defines model for SaveExchangeRatesResponse.
+type SaveExchangeRatesResponse struct {
+	Saved int `json:"saved"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// SettleUpTransfer defines model for SettleUpTransfer.
+type SettleUpTransfer struct {
+	Amount            string `json:"amount"`
+	FromParticipantID string `json:"from_participant_id"`
+	ToParticipantID   string `json:"to_participant_id"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// Task defines model for Task.
+type Task struct {
+	AssigneeID  *string             `json:"assignee_id"`
+	Description *string             `json:"description"`
+	DueOn       *openapi_types.Date `json:"due_on"`
+	ID          string              `json:"id"`
+
+	// Whether the due date has passed while the task isn't done.
+	Overdue bool `json:"overdue"`
+
+	// Progress of a task.
+	Status TaskStatus `json:"status"`
+	Title  string     `json:"title"`
+	TripID string     `json:"trip_id"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+// TaskComment defines model for TaskComment.
+type TaskComment struct {
+	// Null once the author left the trip.
+	AuthorID  *string   `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+// TaskCommentRequest defines model for TaskCommentRequest.
+type TaskCommentRequest struct {
+	// Participant writing the comment.
+	AuthorID string `json:"author_id" validate:"required,uuid"`
+	Body     string `json:"body" validate:"required,max=2000"`
+}
+
+// TaskRequest defines model for TaskRequest.
+type TaskRequest struct {
+	// Participant in charge of the task.
+	AssigneeID  *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=2000"`
+
+	// Day the task should be done by. Assignees are reminded by email once it has passed, or the trip owner for unassigned tasks.
+	DueOn *openapi_types.Date `json:"due_on,omitempty"`
+
+	// Progress of a task.
+	Status *TaskStatus `json:"status,omitempty"`
+	Title  string      `json:"title" validate:"required,max=255"`
+}
+
+// Data of the events sent on the trip event stream.
+type TripEvent struct {
+	// Fields that changed, for updates. They are columns of the subject, except for attendees when someone signs up for an activity or leaves it, and legs when the route of the trip is replaced. Fetch the subject for its current state.
+	Fields []string `json:"fields"`
+
+	// Position of the event in the trip log, sent again as Last-Event-ID to resume.
+	ID         string    `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// The trip, activity, link or participant that changed.
+	SubjectID string        `json:"subject_id"`
+	TripID    string        `json:"trip_id"`
+	Type      TripEventType `json:"type"`
+}
+
+// TripLeg defines model for TripLeg.
+type TripLeg struct {
+	Destination string             `json:"destination"`
+	EndsOn      openapi_types.Date `json:"ends_on"`
+	ID          string             `json:"id"`
+	StartsOn    openapi_types.Date `json:"starts_on"`
+	Timezone    string             `json:"timezone"`
+}
+
+// TripLegRequest defines model for TripLegRequest.
+type TripLegRequest struct {
+	Destination string             `json:"destination" validate:"required,max=255"`
+	EndsOn      openapi_types.Date `json:"ends_on" validate:"required"`
+
+	// Keep an existing leg, along with the activities assigned to it. Leave it out to add a leg.
+	ID       *string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	StartsOn openapi_types.Date `json:"starts_on" validate:"required"`
+
+	// IANA time zone of the destination.
+	Timezone string `json:"timezone" validate:"required,timezone"`
+}
+
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	// Maximum number of attendees. Leave it out for no limit.
+	Capacity      *int   `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	EstimatedCost *Money `json:"estimated_cost,omitempty"`
+
+	// Leg of the route the activity takes place on. It must occur during the leg, in the leg's time zone.
+	LegID    *string   `json:"leg_id,omitempty" validate:"omitempty,uuid"`
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
+}
+
+// UpdateCommentRequest defines model for UpdateCommentRequest.
+type UpdateCommentRequest struct {
+	// Mention participants with @ followed by their email, e.g. @ana@example.com. Mentioned participants are notified by email.
+	Body string `json:"body" validate:"required,max=4000"`
+}
+
+// UpdateLinkRequest defines model for UpdateLinkRequest.
+type UpdateLinkRequest struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required,url,urlscheme=http https"`
+}
+
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	// Maximum number of confirmed participants, not counting the owner. Leave it out for no limit.
+	Capacity    *int      `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	Destination string    `json:"destination" validate:"required,min=4"`
+	EndsAt      time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+
+	// ISO 4217 code that expense totals are converted to. Leave it out to keep totals per currency. It can't change while the trip has budgets.
+	HomeCurrency *string   `json:"home_currency,omitempty" validate:"omitempty,currency"`
+	StartsAt     time.Time `json:"starts_at" validate:"required"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	AllTrips  bool      `json:"all_trips"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Event types delivered, every type when empty.
+	Events []TripEventType `json:"events"`
+	ID     string          `json:"id"`
+	URL    string          `json:"url"`
+}
+
+// WebhookAttempt defines model for WebhookAttempt.
+type WebhookAttempt struct {
+	AttemptedAt time.Time `json:"attempted_at"`
+	DurationMs  int       `json:"duration_ms"`
+	Error       *string   `json:"error"`
+
+	// Null when no response came back.
+	StatusCode *int `json:"status_code"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  []WebhookAttempt `json:"attempts"`
+	CreatedAt time.Time        `json:"created_at"`
+
+	// Data of the events sent on the trip event stream.
+	Event TripEvent `json:"event"`
+	ID    string    `json:"id"`
+
+	// Null once the delivery succeeded or failed.
+	NextAttemptAt *time.Time            `json:"next_attempt_at"`
+	Status        WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	// Deliver the events of every trip of the owner instead of only this one.
+	AllTrips *bool `json:"all_trips,omitempty"`
+
+	// Event types to deliver, every type when left out or empty.
+	Events []TripEventType `json:"events,omitempty"`
+
+	// Where the events are POSTed. It must resolve to a public address.
+	URL string `json:"url" validate:"required,url,max=2048,urlscheme=http https"`
+}
+
+// BulkInviteResultStatus defines model for BulkInviteResult.Status.
+type BulkInviteResultStatus struct {
+	value string
+}
+
+func (t *BulkInviteResultStatus) ToValue() string {
+	return t.value
+}
+func (t BulkInviteResultStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *BulkInviteResultStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *BulkInviteResultStatus) FromValue(value string) error {
+	switch value {
+
+	case BulkInviteResultStatusCreated.value:
+		t.value = value
+		return nil
+
+	case BulkInviteResultStatusDuplicate.value:
+		t.value = value
+		return nil
+
+	case BulkInviteResultStatusInvalid.value:
+		t.value = value
+		return nil
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// Format of the incoming webhook, platforms compatible with Slack or Discord webhooks work as well.
+type ChatPlatform struct {
+	value string
+}
+
+func (t *ChatPlatform) ToValue() string {
+	return t.value
+}
+func (t ChatPlatform) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ChatPlatform) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ChatPlatform) FromValue(value string) error {
+	switch value {
+
+	case ChatPlatformDiscord.value:
+		t.value = value
+		return nil
+
+	case ChatPlatformSlack.value:
+		t.value = value
+		return nil
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// What an expense was for. Expenses tied to an activity default to activities, the others to other.
+type ExpenseCategory struct {
+	value string
+}
+
+func (t *ExpenseCategory) ToValue() string {
+	return t.value
+}
+func (t ExpenseCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ExpenseCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ExpenseCategory) FromValue(value string) error {
+	switch value {
+
+	case ExpenseCategoryActivities.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryFood.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryLodging.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryOther.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryTransport.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+// What a participant may do on the trip. Editors can change the plan, viewers can only read it.
+type ParticipantRole struct {
+	value string
+}
+
+func (t *ParticipantRole) ToValue() string {
+	return t.value
+}
+func (t ParticipantRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ParticipantRole) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ParticipantRole) FromValue(value string) error {
+	switch value {
+
+	case ParticipantRoleEditor.value:
+		t.value = value
+		return nil
+
+	case ParticipantRoleViewer.value:
+		t.value = value
+		return nil
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// What a poll decides: which proposed activity makes it into the itinerary, or which dates the trip takes place on.
+type PollKind struct {
+	value string
+}
+
+func (t *PollKind) ToValue() string {
+	return t.value
+}
+func (t PollKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PollKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *PollKind) FromValue(value string) error {
+	switch value {
+
+	case PollKindActivity.value:
+		t.value = value
+		return nil
+
+	case PollKindDates.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// What a reservation books: a place to stay, a flight, a train ride or a rental car.
+type ReservationKind struct {
+	value string
+}
+
+func (t *ReservationKind) ToValue() string {
+	return t.value
+}
+func (t ReservationKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ReservationKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ReservationKind) FromValue(value string) error {
+	switch value {
+
+	case ReservationKindCarRental.value:
+		t.value = value
+		return nil
+
+	case ReservationKindFlight.value:
+		t.value = value
+		return nil
+
+	case ReservationKindLodging.value:
+		t.value = value
+		return nil
+
+	case ReservationKindTrain.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+// How an expense is divided: evenly, in proportion to shares, or by exact amounts that add up to the expense.
+type SplitMode struct {
+	value string
+}
+
+func (t *SplitMode) ToValue() string {
+	return t.value
+}
+func (t SplitMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SplitMode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *SplitMode) FromValue(value string) error {
+	switch value {
+
+	case SplitModeEqual.value:
+		t.value = value
+		return nil
+
+	case SplitModeExact.value:
+		t.value = value
+		return nil
+
+	case SplitModeShares.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// Progress of a task.
+type TaskStatus struct {
+	value string
+}
+
+func (t *TaskStatus) ToValue() string {
+	return t.value
+}
+func (t TaskStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TaskStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TaskStatus) FromValue(value string) error {
+	switch value {
+
+	case TaskStatusDone.value:
+		t.value = value
+		return nil
+
+	case TaskStatusInProgress.value:
+		t.value = value
+		return nil
+
+	case TaskStatusTodo.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// TripEventType defines model for TripEventType.
+type TripEventType struct {
+	value string
+}
+
+func (t *TripEventType) ToValue() string {
+	return t.value
+}
+func (t TripEventType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TripEventType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TripEventType) FromValue(value string) error {
+	switch value {
+
+	case TripEventTypeActivityCreated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeActivityDeleted.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeActivityUpdated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeLinkCreated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeLinkDeleted.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeLinkUpdated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeParticipantCreated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeParticipantDeleted.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeParticipantUpdated.value:
+		t.value = value
+		return nil
+
+	case TripEventTypeTripUpdated.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Vote defines model for Vote.
+type Vote struct {
+	value string
+}
+
+func (t *Vote) ToValue() string {
+	return t.value
+}
+func (t Vote) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *Vote) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *Vote) FromValue(value string) error {
+	switch value {
+
+	case VoteDown.value:
+		t.value = value
+		return nil
+
+	case VoteUp.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus struct {
+	value string
+}
+
+func (t *WebhookDeliveryStatus) ToValue() string {
+	return t.value
+}
+func (t WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *WebhookDeliveryStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *WebhookDeliveryStatus) FromValue(value string) error {
+	switch value {
+
+	case WebhookDeliveryStatusFailed.value:
+		t.value = value
+		return nil
+
+	case WebhookDeliveryStatusPending.value:
+		t.value = value
+		return nil
+
+	case WebhookDeliveryStatusSucceeded.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// GetExchangeRatesParams defines parameters for GetExchangeRates.
+type GetExchangeRatesParams struct {
+	// Defaults to today.
+	On *openapi_types.Date `json:"on,omitempty"`
+}
+
+// PutExchangeRatesJSONBody defines parameters for PutExchangeRates.
+type PutExchangeRatesJSONBody ExchangeRatesUpload
+
+// PostJoinCodeJSONBody defines parameters for PostJoinCode.
+type PostJoinCodeJSONBody JoinTripRequest
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// PostTripsParams defines parameters for PostTrips.
+type PostTripsParams struct {
+	// Replay the stored response when the same request is retried with this key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTripsTripIDParams defines parameters for GetTripsTripID.
+type GetTripsTripIDParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PatchTripsTripIDParams defines parameters for PatchTripsTripID.
+type PatchTripsTripIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// PutTripsTripIDParams defines parameters for PutTripsTripID.
+type PutTripsTripIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PostTripsTripIDActivitiesParams defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesParams struct {
+	// Replay the stored response when the same request is retried with this key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParams defines parameters for DeleteTripsTripIDActivitiesActivityID.
+type DeleteTripsTripIDActivitiesActivityIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDActivitiesActivityIDParams defines parameters for GetTripsTripIDActivitiesActivityID.
+type GetTripsTripIDActivitiesActivityIDParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// PutTripsTripIDActivitiesActivityIDParams defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// DeleteTripsTripIDBudgetsCategoryParamsCategory defines parameters for DeleteTripsTripIDBudgetsCategory.
+type DeleteTripsTripIDBudgetsCategoryParamsCategory string
+
+// PutTripsTripIDBudgetsCategoryJSONBody defines parameters for PutTripsTripIDBudgetsCategory.
+type PutTripsTripIDBudgetsCategoryJSONBody BudgetRequest
+
+// PutTripsTripIDBudgetsCategoryParamsCategory defines parameters for PutTripsTripIDBudgetsCategory.
+type PutTripsTripIDBudgetsCategoryParamsCategory string
+
+// PostTripsTripIDChatChannelsJSONBody defines parameters for PostTripsTripIDChatChannels.
+type PostTripsTripIDChatChannelsJSONBody ChatChannelRequest
+
+// GetTripsTripIDChecklistsParams defines parameters for GetTripsTripIDChecklists.
+type GetTripsTripIDChecklistsParams struct {
+	// Only list the shared checklists and the personal ones of this participant.
+	ParticipantID *string `json:"participant_id,omitempty"`
+}
+
+// PostTripsTripIDChecklistsJSONBody defines parameters for PostTripsTripIDChecklists.
+type PostTripsTripIDChecklistsJSONBody ChecklistRequest
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSONBody defines parameters for PostTripsTripIDChecklistsChecklistIDItems.
+type PostTripsTripIDChecklistsChecklistIDItemsJSONBody ChecklistItemRequest
+
+// PutTripsTripIDChecklistsChecklistIDItemsOrderJSONBody defines parameters for PutTripsTripIDChecklistsChecklistIDItemsOrder.
+type PutTripsTripIDChecklistsChecklistIDItemsOrderJSONBody ChecklistOrderRequest
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody defines parameters for PutTripsTripIDChecklistsChecklistIDItemsItemID.
+type PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody ChecklistItemRequest
+
+// PostTripsTripIDChecklistsChecklistIDTemplateJSONBody defines parameters for PostTripsTripIDChecklistsChecklistIDTemplate.
+type PostTripsTripIDChecklistsChecklistIDTemplateJSONBody ChecklistTemplateRequest
+
+// GetTripsTripIDCollaborationParams defines parameters for GetTripsTripIDCollaboration.
+type GetTripsTripIDCollaborationParams struct {
+	// Confirmed participant opening the channel.
+	ParticipantID string `json:"participant_id"`
+}
+
+// GetTripsTripIDCommentsParams defines parameters for GetTripsTripIDComments.
+type GetTripsTripIDCommentsParams struct {
+	// List the comments on this activity.
+	ActivityID *string `json:"activity_id,omitempty"`
+
+	// List the comments on this link.
+	LinkID *string `json:"link_id,omitempty"`
+
+	// List the replies to this comment. Replies are about what their parent is about, so activity_id and link_id are ignored along with it.
+	ParentID *string `json:"parent_id,omitempty"`
+
+	// Maximum number of comments in the page.
+	Limit *int `json:"limit,omitempty"`
+
+	// next_cursor of the previous page.
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// PostTripsTripIDCommentsJSONBody defines parameters for PostTripsTripIDComments.
+type PostTripsTripIDCommentsJSONBody CommentRequest
+
+// DeleteTripsTripIDCommentsCommentIDParams defines parameters for DeleteTripsTripIDCommentsCommentID.
+type DeleteTripsTripIDCommentsCommentIDParams struct {
+	// Participant making the change. Only the author of a comment may change it.
+	XParticipantID string `json:"X-Participant-ID"`
+
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutTripsTripIDCommentsCommentIDJSONBody defines parameters for PutTripsTripIDCommentsCommentID.
+type PutTripsTripIDCommentsCommentIDJSONBody UpdateCommentRequest
+
+// PutTripsTripIDCommentsCommentIDParams defines parameters for PutTripsTripIDCommentsCommentID.
+type PutTripsTripIDCommentsCommentIDParams struct {
+	// Participant making the change. Only the author of a comment may change it.
+	XParticipantID string `json:"X-Participant-ID"`
+
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDEventsParams defines parameters for GetTripsTripIDEvents.
+type GetTripsTripIDEventsParams struct {
+	// id of the last event received, to resume a stream.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// PostTripsTripIDExpensesJSONBody defines parameters for PostTripsTripIDExpenses.
+type PostTripsTripIDExpensesJSONBody CreateExpenseRequest
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// PostTripsTripIDInvitesParams defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesParams struct {
+	// Replay the stored response when the same request is retried with this key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// PostTripsTripIDInvitesBulkJSONBody defines parameters for PostTripsTripIDInvitesBulk.
+type PostTripsTripIDInvitesBulkJSONBody BulkInviteRequest
+
+// PostTripsTripIDInvitesBulkParams defines parameters for PostTripsTripIDInvitesBulk.
+type PostTripsTripIDInvitesBulkParams struct {
+	// Replay the stored response when the same request is retried with this key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// PostTripsTripIDJoinLinksJSONBody defines parameters for PostTripsTripIDJoinLinks.
+type PostTripsTripIDJoinLinksJSONBody CreateJoinLinkRequest
+
+// GetTripsTripIDLinksParams defines parameters for GetTripsTripIDLinks.
+type GetTripsTripIDLinksParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PostTripsTripIDLinksParams defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksParams struct {
+	// Replay the stored response when the same request is retried with this key.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteTripsTripIDLinksLinkIDParams defines parameters for DeleteTripsTripIDLinksLinkID.
+type DeleteTripsTripIDLinksLinkIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDLinksLinkIDParams defines parameters for GetTripsTripIDLinksLinkID.
+type GetTripsTripIDLinksLinkIDParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PutTripsTripIDLinksLinkIDParams defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDOverviewParams defines parameters for GetTripsTripIDOverview.
+type GetTripsTripIDOverviewParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PostTripsTripIDPollsJSONBody defines parameters for PostTripsTripIDPolls.
+type PostTripsTripIDPollsJSONBody CreatePollRequest
+
+// PostTripsTripIDPollsPollIDOptionsJSONBody defines parameters for PostTripsTripIDPollsPollIDOptions.
+type PostTripsTripIDPollsPollIDOptionsJSONBody AddPollOptionsRequest
+
+// PutTripsTripIDPollsPollIDVotesParticipantIDJSONBody defines parameters for PutTripsTripIDPollsPollIDVotesParticipantID.
+type PutTripsTripIDPollsPollIDVotesParticipantIDJSONBody BallotRequest
+
+// PostTripsTripIDReservationsJSONBody defines parameters for PostTripsTripIDReservations.
+type PostTripsTripIDReservationsJSONBody ReservationRequest
+
+// DeleteTripsTripIDReservationsReservationIDParams defines parameters for DeleteTripsTripIDReservationsReservationID.
+type DeleteTripsTripIDReservationsReservationIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDReservationsReservationIDParams defines parameters for GetTripsTripIDReservationsReservationID.
+type GetTripsTripIDReservationsReservationIDParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PutTripsTripIDReservationsReservationIDJSONBody defines parameters for PutTripsTripIDReservationsReservationID.
+type PutTripsTripIDReservationsReservationIDJSONBody ReservationRequest
+
+// PutTripsTripIDReservationsReservationIDParams defines parameters for PutTripsTripIDReservationsReservationID.
+type PutTripsTripIDReservationsReservationIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutTripsTripIDRouteJSONBody defines parameters for PutTripsTripIDRoute.
+type PutTripsTripIDRouteJSONBody RouteRequest
+
+// PutTripsTripIDRouteParams defines parameters for PutTripsTripIDRoute.
+type PutTripsTripIDRouteParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostTripsTripIDTasksJSONBody defines parameters for PostTripsTripIDTasks.
+type PostTripsTripIDTasksJSONBody TaskRequest
+
+// DeleteTripsTripIDTasksTaskIDParams defines parameters for DeleteTripsTripIDTasksTaskID.
+type DeleteTripsTripIDTasksTaskIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDTasksTaskIDParams defines parameters for GetTripsTripIDTasksTaskID.
+type GetTripsTripIDTasksTaskIDParams struct {
+	// Answer with 304 when the ETag still matches.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PutTripsTripIDTasksTaskIDJSONBody defines parameters for PutTripsTripIDTasksTaskID.
+type PutTripsTripIDTasksTaskIDJSONBody TaskRequest
+
+// PutTripsTripIDTasksTaskIDParams defines parameters for PutTripsTripIDTasksTaskID.
+type PutTripsTripIDTasksTaskIDParams struct {
+	// Fail with 412 unless the ETag still matches.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostTripsTripIDTasksTaskIDCommentsJSONBody defines parameters for PostTripsTripIDTasksTaskIDComments.
+type PostTripsTripIDTasksTaskIDCommentsJSONBody TaskCommentRequest
+
+// PostTripsTripIDWebhooksJSONBody defines parameters for PostTripsTripIDWebhooks.
+type PostTripsTripIDWebhooksJSONBody WebhookRequest
+
+// GetTripsTripIDWebhooksWebhookIDDeliveriesParams defines parameters for GetTripsTripIDWebhooksWebhookIDDeliveries.
+type GetTripsTripIDWebhooksWebhookIDDeliveriesParams struct {
+	// Maximum number of deliveries.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// PutExchangeRatesJSONRequestBody defines body for PutExchangeRates for application/json ContentType.
+type PutExchangeRatesJSONRequestBody PutExchangeRatesJSONBody
+
+// Bind implements render.Binder.
+func (PutExchangeRatesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostJoinCodeJSONRequestBody defines body for PostJoinCode for application/json ContentType.
+type PostJoinCodeJSONRequestBody PostJoinCodeJSONBody
+
+// Bind implements render.Binder.
+func (PostJoinCodeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDBudgetsCategoryJSONRequestBody defines body for PutTripsTripIDBudgetsCategory for application/json ContentType.
+type PutTripsTripIDBudgetsCategoryJSONRequestBody PutTripsTripIDBudgetsCategoryJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDBudgetsCategoryJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChatChannelsJSONRequestBody defines body for PostTripsTripIDChatChannels for application/json ContentType.
+type PostTripsTripIDChatChannelsJSONRequestBody PostTripsTripIDChatChannelsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChatChannelsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChecklistsJSONRequestBody defines body for PostTripsTripIDChecklists for application/json ContentType.
+type PostTripsTripIDChecklistsJSONRequestBody PostTripsTripIDChecklistsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody defines body for PostTripsTripIDChecklistsChecklistIDItems for application/json ContentType.
+type PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody PostTripsTripIDChecklistsChecklistIDItemsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistsChecklistIDItemsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsOrderJSONRequestBody defines body for PutTripsTripIDChecklistsChecklistIDItemsOrder for application/json ContentType.
+type PutTripsTripIDChecklistsChecklistIDItemsOrderJSONRequestBody PutTripsTripIDChecklistsChecklistIDItemsOrderJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistsChecklistIDItemsOrderJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody defines body for PutTripsTripIDChecklistsChecklistIDItemsItemID for application/json ContentType.
+type PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistsChecklistIDItemsItemIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChecklistsChecklistIDTemplateJSONRequestBody defines body for PostTripsTripIDChecklistsChecklistIDTemplate for application/json ContentType.
+type PostTripsTripIDChecklistsChecklistIDTemplateJSONRequestBody PostTripsTripIDChecklistsChecklistIDTemplateJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistsChecklistIDTemplateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDCommentsJSONRequestBody defines body for PostTripsTripIDComments for application/json ContentType.
+type PostTripsTripIDCommentsJSONRequestBody PostTripsTripIDCommentsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDCommentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDCommentsCommentIDJSONRequestBody defines body for PutTripsTripIDCommentsCommentID for application/json ContentType.
+type PutTripsTripIDCommentsCommentIDJSONRequestBody PutTripsTripIDCommentsCommentIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDCommentsCommentIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesJSONRequestBody defines body for PostTripsTripIDExpenses for application/json ContentType.
+type PostTripsTripIDExpensesJSONRequestBody PostTripsTripIDExpensesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesBulkJSONRequestBody defines body for PostTripsTripIDInvitesBulk for application/json ContentType.
+type PostTripsTripIDInvitesBulkJSONRequestBody PostTripsTripIDInvitesBulkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesBulkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDJoinLinksJSONRequestBody defines body for PostTripsTripIDJoinLinks for application/json ContentType.
+type PostTripsTripIDJoinLinksJSONRequestBody PostTripsTripIDJoinLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDJoinLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPollsJSONRequestBody defines body for PostTripsTripIDPolls for application/json ContentType.
+type PostTripsTripIDPollsJSONRequestBody PostTripsTripIDPollsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPollsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPollsPollIDOptionsJSONRequestBody defines body for PostTripsTripIDPollsPollIDOptions for application/json ContentType.
+type PostTripsTripIDPollsPollIDOptionsJSONRequestBody PostTripsTripIDPollsPollIDOptionsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPollsPollIDOptionsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDPollsPollIDVotesParticipantIDJSONRequestBody defines body for PutTripsTripIDPollsPollIDVotesParticipantID for application/json ContentType.
+type PutTripsTripIDPollsPollIDVotesParticipantIDJSONRequestBody PutTripsTripIDPollsPollIDVotesParticipantIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDPollsPollIDVotesParticipantIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDReservationsJSONRequestBody defines body for PostTripsTripIDReservations for application/json ContentType.
+type PostTripsTripIDReservationsJSONRequestBody PostTripsTripIDReservationsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDReservationsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDReservationsReservationIDJSONRequestBody defines body for PutTripsTripIDReservationsReservationID for application/json ContentType.
+type PutTripsTripIDReservationsReservationIDJSONRequestBody PutTripsTripIDReservationsReservationIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDReservationsReservationIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDRouteJSONRequestBody defines body for PutTripsTripIDRoute for application/json ContentType.
+type PutTripsTripIDRouteJSONRequestBody PutTripsTripIDRouteJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDRouteJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTasksJSONRequestBody defines body for PostTripsTripIDTasks for application/json ContentType.
+type PostTripsTripIDTasksJSONRequestBody PostTripsTripIDTasksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTasksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDTasksTaskIDJSONRequestBody defines body for PutTripsTripIDTasksTaskID for application/json ContentType.
+type PutTripsTripIDTasksTaskIDJSONRequestBody PutTripsTripIDTasksTaskIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDTasksTaskIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTasksTaskIDCommentsJSONRequestBody defines body for PostTripsTripIDTasksTaskIDComments for application/json ContentType.
+type PostTripsTripIDTasksTaskIDCommentsJSONRequestBody PostTripsTripIDTasksTaskIDCommentsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTasksTaskIDCommentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDWebhooksJSONRequestBody defines body for PostTripsTripIDWebhooks for application/json ContentType.
+type PostTripsTripIDWebhooksJSONRequestBody PostTripsTripIDWebhooksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDWebhooksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// GetExchangeRatesJSON200Response is a constructor method for a GetExchangeRates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetExchangeRatesJSON200Response(body GetExchangeRatesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutExchangeRatesJSON200Response is a constructor method for a PutExchangeRates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutExchangeRatesJSON200Response(body SaveExchangeRatesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostExchangeRatesRefreshJSON200Response is a constructor method for a PostExchangeRatesRefresh response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostExchangeRatesRefreshJSON200Response(body SaveExchangeRatesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostJoinCodeJSON201Response is a constructor method for a PostJoinCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostJoinCodeJSON201Response(body JoinTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON202Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDDeclineJSON204Response is a constructor method for a PostParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDDeclineJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDItineraryJSON200Response is a constructor method for a GetParticipantsParticipantIDItinerary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDItineraryJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDResendInviteJSON204Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDResendInviteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDRevokeJSON204Response is a constructor method for a PostParticipantsParticipantIDRevoke response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDRevokeJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDTasksJSON200Response is a constructor method for a GetParticipantsParticipantIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDTasksJSON200Response(body GetTasksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDJSON200Response is a constructor method for a PatchTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON200Response(body GetActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityIDAttendeesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDAttendeesParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBalancesJSON200Response is a constructor method for a GetTripsTripIDBalances response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBalancesJSON200Response(body GetBalancesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetJSON200Response is a constructor method for a GetTripsTripIDBudget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetJSON200Response(body GetBudgetResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDBudgetsCategoryJSON204Response is a constructor method for a DeleteTripsTripIDBudgetsCategory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDBudgetsCategoryJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBudgetsCategoryJSON204Response is a constructor method for a PutTripsTripIDBudgetsCategory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBudgetsCategoryJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChatChannelsJSON200Response is a constructor method for a GetTripsTripIDChatChannels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChatChannelsJSON200Response(body GetChatChannelsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChatChannelsJSON201Response is a constructor method for a PostTripsTripIDChatChannels response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChatChannelsJSON201Response(body CreateChatChannelResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChatChannelsChannelIDJSON204Response is a constructor method for a DeleteTripsTripIDChatChannelsChannelID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChatChannelsChannelIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChatChannelsChannelIDTestJSON204Response is a constructor method for a PostTripsTripIDChatChannelsChannelIDTest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChatChannelsChannelIDTestJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsJSON200Response is a constructor method for a GetTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsJSON200Response(body GetChecklistsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsJSON201Response is a constructor method for a PostTripsTripIDChecklists response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsJSON201Response(body CreateChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsTemplatesJSON200Response is a constructor method for a GetTripsTripIDChecklistsTemplates response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsTemplatesJSON200Response(body GetChecklistTemplatesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsTemplatesTemplateIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsTemplatesTemplateID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsTemplatesTemplateIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistsChecklistIDJSON200Response is a constructor method for a GetTripsTripIDChecklistsChecklistID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistsChecklistIDJSON200Response(body GetChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDItemsJSON201Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDItemsJSON201Response(body CreateChecklistItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsOrderJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsOrderJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response is a constructor method for a DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response is a constructor method for a PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistsChecklistIDItemsItemIDCheckJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistsChecklistIDTemplateJSON201Response is a constructor method for a PostTripsTripIDChecklistsChecklistIDTemplate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistsChecklistIDTemplateJSON201Response(body CreateChecklistTemplateResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCommentsJSON200Response is a constructor method for a GetTripsTripIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCommentsJSON200Response(body GetCommentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCommentsJSON201Response is a constructor method for a PostTripsTripIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCommentsJSON201Response(body CreateCommentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDCommentsCommentIDJSON204Response is a constructor method for a DeleteTripsTripIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDCommentsCommentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDCommentsCommentIDJSON204Response is a constructor method for a PutTripsTripIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDCommentsCommentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON200Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON200Response(body GetExpensesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON201Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDJSON200Response is a constructor method for a GetTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDJSON200Response(body GetExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesBulkJSON200Response is a constructor method for a PostTripsTripIDInvitesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesBulkJSON200Response(body BulkInviteResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJoinLinksJSON200Response is a constructor method for a GetTripsTripIDJoinLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJoinLinksJSON200Response(body GetJoinLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJoinLinksJSON201Response is a constructor method for a PostTripsTripIDJoinLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJoinLinksJSON201Response(body CreateJoinLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJoinLinksJoinLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDJoinLinksJoinLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJoinLinksJoinLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON200Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON200Response(body GetLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOverviewJSON200Response is a constructor method for a GetTripsTripIDOverview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOverviewJSON200Response(body GetTripOverviewResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPollsJSON200Response is a constructor method for a GetTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPollsJSON200Response(body GetPollsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsJSON201Response is a constructor method for a PostTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsJSON201Response(body CreatePollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPollsPollIDJSON200Response is a constructor method for a GetTripsTripIDPollsPollID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPollsPollIDJSON200Response(body GetPollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDCloseJSON200Response is a constructor method for a PostTripsTripIDPollsPollIDClose response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDCloseJSON200Response(body GetPollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDOptionsJSON201Response is a constructor method for a PostTripsTripIDPollsPollIDOptions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDOptionsJSON201Response(body AddPollOptionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPollsPollIDVotesParticipantIDJSON204Response is a constructor method for a PutTripsTripIDPollsPollIDVotesParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPollsPollIDVotesParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDReservationsJSON200Response is a constructor method for a GetTripsTripIDReservations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDReservationsJSON200Response(body GetReservationsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReservationsJSON201Response is a constructor method for a PostTripsTripIDReservations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReservationsJSON201Response(body CreateReservationResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDReservationsReservationIDJSON204Response is a constructor method for a DeleteTripsTripIDReservationsReservationID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDReservationsReservationIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDReservationsReservationIDJSON200Response is a constructor method for a GetTripsTripIDReservationsReservationID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDReservationsReservationIDJSON200Response(body GetReservationResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDReservationsReservationIDJSON204Response is a constructor method for a PutTripsTripIDReservationsReservationID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDReservationsReservationIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDRouteJSON200Response is a constructor method for a PutTripsTripIDRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDRouteJSON200Response(body RouteResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON200Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON200Response(body GetTasksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON201Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON201Response(body CreateTaskResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON204Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksTaskIDJSON200Response is a constructor method for a GetTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksTaskIDJSON200Response(body GetTaskResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON204Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksTaskIDCommentsJSON201Response is a constructor method for a PostTripsTripIDTasksTaskIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksTaskIDCommentsJSON201Response(body CreateTaskCommentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON200Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON200Response(body GetWebhooksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON201Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON201Response(body CreateWebhookResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDWebhooksWebhookIDJSON204Response is a constructor method for a DeleteTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDWebhooksWebhookIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksWebhookIDDeliveriesJSON200Response is a constructor method for a GetTripsTripIDWebhooksWebhookIDDeliveries response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksWebhookIDDeliveriesJSON200Response(body GetWebhookDeliveriesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliverJSON202Response is a constructor method for a PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliverJSON202Response(body RedeliverWebhookResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the exchange rates in effect on a day.
+	// (GET /exchange-rates)
+	GetExchangeRates(w http.ResponseWriter, r *http.Request, params GetExchangeRatesParams) *Response
+	// Upload exchange rates.
+	// (PUT /exchange-rates)
+	PutExchangeRates(w http.ResponseWriter, r *http.Request) *Response
+	// Load the exchange rates of the configured provider.
+	// (POST /exchange-rates/refresh)
+	PostExchangeRatesRefresh(w http.ResponseWriter, r *http.Request) *Response
+	// Join a trip through a shareable link.
+	// (POST /join/{code})
+	PostJoinCode(w http.ResponseWriter, r *http.Request, code string) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Decline a trip invitation.
+	// (POST /participants/{participantId}/decline)
+	PostParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get the activities a participant signed up for.
+	// (GET /participants/{participantId}/itinerary)
+	GetParticipantsParticipantIDItinerary(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Send the invitation email to a participant again.
+	// (POST /participants/{participantId}/resend-invite)
+	PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Revoke a participant's invitation.
+	// (POST /participants/{participantId}/revoke)
+	PostParticipantsParticipantIDRevoke(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get the tasks assigned to a participant.
+	// (GET /participants/{participantId}/tasks)
+	GetParticipantsParticipantIDTasks(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request, params PostTripsParams) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParams) *Response
+	// Partially update a trip.
+	// (PATCH /trips/{tripId})
+	PatchTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDParams) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDParams) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesParams) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params DeleteTripsTripIDActivitiesActivityIDParams) *Response
+	// Get a trip activity.
+	// (GET /trips/{tripId}/activities/{activityId})
+	GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params GetTripsTripIDActivitiesActivityIDParams) *Response
+	// Update a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PutTripsTripIDActivitiesActivityIDParams) *Response
+	// Take a participant off an activity.
+	// (DELETE /trips/{tripId}/activities/{activityId}/attendees/{participantId})
+	DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *Response
+	// Sign a participant up for an activity.
+	// (PUT /trips/{tripId}/activities/{activityId}/attendees/{participantId})
+	PutTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *Response
+	// Get who owes what on a trip and how to settle up.
+	// (GET /trips/{tripId}/balances)
+	GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip budget with planned and actual spend per category.
+	// (GET /trips/{tripId}/budget)
+	GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove the budget of a category.
+	// (DELETE /trips/{tripId}/budgets/{category})
+	DeleteTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request, tripID string, category DeleteTripsTripIDBudgetsCategoryParamsCategory) *Response
+	// Set the budget of a category.
+	// (PUT /trips/{tripId}/budgets/{category})
+	PutTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request, tripID string, category PutTripsTripIDBudgetsCategoryParamsCategory) *Response
+	// Export a trip itinerary as an iCalendar file.
+	// (GET /trips/{tripId}/calendar.ics)
+	GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the chat channels of a trip.
+	// (GET /trips/{tripId}/chat-channels)
+	GetTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add a chat channel for the events of a trip.
+	// (POST /trips/{tripId}/chat-channels)
+	PostTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a chat channel.
+	// (DELETE /trips/{tripId}/chat-channels/{channelId})
+	DeleteTripsTripIDChatChannelsChannelID(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *Response
+	// Post a test message to a chat channel.
+	// (POST /trips/{tripId}/chat-channels/{channelId}/test)
+	PostTripsTripIDChatChannelsChannelIDTest(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *Response
+	// Get a trip checklists.
+	// (GET /trips/{tripId}/checklists)
+	GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDChecklistsParams) *Response
+	// Create a checklist on a trip.
+	// (POST /trips/{tripId}/checklists)
+	PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the checklist templates of a trip owner.
+	// (GET /trips/{tripId}/checklists/templates)
+	GetTripsTripIDChecklistsTemplates(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a checklist template of a trip owner.
+	// (DELETE /trips/{tripId}/checklists/templates/{templateId})
+	DeleteTripsTripIDChecklistsTemplatesTemplateID(w http.ResponseWriter, r *http.Request, tripID string, templateID string) *Response
+	// Delete a trip checklist.
+	// (DELETE /trips/{tripId}/checklists/{checklistId})
+	DeleteTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Get a trip checklist.
+	// (GET /trips/{tripId}/checklists/{checklistId})
+	GetTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Add an item to a checklist.
+	// (POST /trips/{tripId}/checklists/{checklistId}/items)
+	PostTripsTripIDChecklistsChecklistIDItems(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Reorder the items of a checklist.
+	// (PUT /trips/{tripId}/checklists/{checklistId}/items/order)
+	PutTripsTripIDChecklistsChecklistIDItemsOrder(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Remove an item from a checklist.
+	// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+	DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Update a checklist item.
+	// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId})
+	PutTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Uncheck a checklist item.
+	// (DELETE /trips/{tripId}/checklists/{checklistId}/items/{itemId}/check)
+	DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Check off a checklist item.
+	// (PUT /trips/{tripId}/checklists/{checklistId}/items/{itemId}/check)
+	PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request, tripID string, checklistID string, itemID string) *Response
+	// Save a checklist as a template.
+	// (POST /trips/{tripId}/checklists/{checklistId}/template)
+	PostTripsTripIDChecklistsChecklistIDTemplate(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *Response
+	// Open the collaboration channel of a trip.
+	// (GET /trips/{tripId}/collaboration)
+	GetTripsTripIDCollaboration(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDCollaborationParams) *Response
+	// Get a page of comments.
+	// (GET /trips/{tripId}/comments)
+	GetTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDCommentsParams) *Response
+	// Comment on a trip, one of its activities or links.
+	// (POST /trips/{tripId}/comments)
+	PostTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a comment.
+	// (DELETE /trips/{tripId}/comments/{commentId})
+	DeleteTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, commentID string, params DeleteTripsTripIDCommentsCommentIDParams) *Response
+	// Edit a comment.
+	// (PUT /trips/{tripId}/comments/{commentId})
+	PutTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, commentID string, params PutTripsTripIDCommentsCommentIDParams) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Stream the changes to a trip.
+	// (GET /trips/{tripId}/events)
+	GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDEventsParams) *Response
+	// Get a trip expenses.
+	// (GET /trips/{tripId}/expenses)
+	GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Log a shared expense on a trip.
+	// (POST /trips/{tripId}/expenses)
+	PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip expense.
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Get a trip expense.
+	// (GET /trips/{tripId}/expenses/{expenseId})
+	GetTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDInvitesParams) *Response
+	// Invite many people to the trip at once.
+	// (POST /trips/{tripId}/invites/bulk)
+	PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDInvitesBulkParams) *Response
+	// Get a trip join links.
+	// (GET /trips/{tripId}/join-links)
+	GetTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a shareable join link for the trip.
+	// (POST /trips/{tripId}/join-links)
+	PostTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Revoke a trip join link.
+	// (DELETE /trips/{tripId}/join-links/{joinLinkId})
+	DeleteTripsTripIDJoinLinksJoinLinkID(w http.ResponseWriter, r *http.Request, tripID string, joinLinkID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDLinksParams) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDLinksParams) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params DeleteTripsTripIDLinksLinkIDParams) *Response
+	// Get a trip link.
+	// (GET /trips/{tripId}/links/{linkId})
+	GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params GetTripsTripIDLinksLinkIDParams) *Response
+	// Update a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params PutTripsTripIDLinksLinkIDParams) *Response
+	// Get a trip with its participants, activities and links.
+	// (GET /trips/{tripId}/overview)
+	GetTripsTripIDOverview(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDOverviewParams) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+	// Get a trip polls.
+	// (GET /trips/{tripId}/polls)
+	GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Open a poll on a trip.
+	// (POST /trips/{tripId}/polls)
+	PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip poll.
+	// (GET /trips/{tripId}/polls/{pollId})
+	GetTripsTripIDPollsPollID(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *Response
+	// Close a poll and apply its winner.
+	// (POST /trips/{tripId}/polls/{pollId}/close)
+	PostTripsTripIDPollsPollIDClose(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *Response
+	// Add options to an open poll.
+	// (POST /trips/{tripId}/polls/{pollId}/options)
+	PostTripsTripIDPollsPollIDOptions(w http.ResponseWriter, r *http.Request, tripID string, pollID string) *Response
+	// Cast a participant's ballot on a poll.
+	// (PUT /trips/{tripId}/polls/{pollId}/votes/{participantId})
+	PutTripsTripIDPollsPollIDVotesParticipantID(w http.ResponseWriter, r *http.Request, tripID string, pollID string, participantID string) *Response
+	// Get a trip reservations.
+	// (GET /trips/{tripId}/reservations)
+	GetTripsTripIDReservations(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Book a reservation on a trip.
+	// (POST /trips/{tripId}/reservations)
+	PostTripsTripIDReservations(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip reservation.
+	// (DELETE /trips/{tripId}/reservations/{reservationId})
+	DeleteTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params DeleteTripsTripIDReservationsReservationIDParams) *Response
+	// Get a trip reservation.
+	// (GET /trips/{tripId}/reservations/{reservationId})
+	GetTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params GetTripsTripIDReservationsReservationIDParams) *Response
+	// Update a trip reservation.
+	// (PUT /trips/{tripId}/reservations/{reservationId})
+	PutTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request, tripID string, reservationID string, params PutTripsTripIDReservationsReservationIDParams) *Response
+	// Replace the route of a trip.
+	// (PUT /trips/{tripId}/route)
+	PutTripsTripIDRoute(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDRouteParams) *Response
+	// Get a trip tasks.
+	// (GET /trips/{tripId}/tasks)
+	GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a task on a trip.
+	// (POST /trips/{tripId}/tasks)
+	PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip task.
+	// (DELETE /trips/{tripId}/tasks/{taskId})
+	DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params DeleteTripsTripIDTasksTaskIDParams) *Response
+	// Get a trip task and its comments.
+	// (GET /trips/{tripId}/tasks/{taskId})
+	GetTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params GetTripsTripIDTasksTaskIDParams) *Response
+	// Update a trip task.
+	// (PUT /trips/{tripId}/tasks/{taskId})
+	PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params PutTripsTripIDTasksTaskIDParams) *Response
+	// Comment on a trip task.
+	// (POST /trips/{tripId}/tasks/{taskId}/comments)
+	PostTripsTripIDTasksTaskIDComments(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *Response
+	// Get the webhooks of a trip.
+	// (GET /trips/{tripId}/webhooks)
+	GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Register a webhook for the events of a trip.
+	// (POST /trips/{tripId}/webhooks)
+	PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a webhook.
+	// (DELETE /trips/{tripId}/webhooks/{webhookId})
+	DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *Response
+	// Get the latest deliveries of a webhook.
+	// (GET /trips/{tripId}/webhooks/{webhookId}/deliveries)
+	GetTripsTripIDWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, params GetTripsTripIDWebhooksWebhookIDDeliveriesParams) *Response
+	// Deliver an event again.
+	// (POST /trips/{tripId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, deliveryID string) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// GetExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExchangeRatesParams
+
+	// ------------- Optional query parameter "on" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "on", r.URL.Query(), &params.On); err != nil {
+		err = fmt.Errorf("invalid format for parameter on: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "on"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetExchangeRates(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) PutExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutExchangeRates(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostExchangeRatesRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostExchangeRatesRefresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostExchangeRatesRefresh(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostJoinCode operation middleware
+func (siw *ServerInterfaceWrapper) PostJoinCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	if err := runtime.BindStyledParameter("simple", false, "code", chi.URLParam(r, "code"), &code); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "code"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostJoinCode(w, r, code)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDDecline(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDItinerary operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDItinerary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDItinerary(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDResendInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDResendInvite(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDRevoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDRevoke(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDTasks(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDActivitiesActivityIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesActivityIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDActivitiesActivityIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID(w, r, tripID, activityID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityIDAttendeesParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityIDAttendeesParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityIDAttendeesParticipantID(w, r, tripID, activityID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBalances(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBudget(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDBudgetsCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "category" -------------
+	var category DeleteTripsTripIDBudgetsCategoryParamsCategory
+
+	if err := runtime.BindStyledParameter("simple", false, "category", chi.URLParam(r, "category"), &category); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "category"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDBudgetsCategory(w, r, tripID, category)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDBudgetsCategory operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "category" -------------
+	var category PutTripsTripIDBudgetsCategoryParamsCategory
+
+	if err := runtime.BindStyledParameter("simple", false, "category", chi.URLParam(r, "category"), &category); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "category"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDBudgetsCategory(w, r, tripID, category)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDCalendarIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDCalendarIcs(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChatChannels operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChatChannels(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChatChannels operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChatChannels(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChatChannelsChannelID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChatChannelsChannelID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "channelId" -------------
+	var channelID string
+
+	if err := runtime.BindStyledParameter("simple", false, "channelId", chi.URLParam(r, "channelId"), &channelID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "channelId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChatChannelsChannelID(w, r, tripID, channelID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChatChannelsChannelIDTest operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChatChannelsChannelIDTest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "channelId" -------------
+	var channelID string
+
+	if err := runtime.BindStyledParameter("simple", false, "channelId", chi.URLParam(r, "channelId"), &channelID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "channelId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChatChannelsChannelIDTest(w, r, tripID, channelID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklists operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDChecklistsParams
+
+	// ------------- Optional query parameter "participant_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "participant_id", r.URL.Query(), &params.ParticipantID); err != nil {
+		err = fmt.Errorf("invalid format for parameter participant_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participant_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklists(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklists operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklists(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklistsTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklistsTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklistsTemplates(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsTemplatesTemplateID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsTemplatesTemplateID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateID string
+
+	if err := runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "templateId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsTemplatesTemplateID(w, r, tripID, templateID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsChecklistID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsChecklistID(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklistsChecklistID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklistsChecklistID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklistsChecklistID(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklistsChecklistIDItems operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklistsChecklistIDItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklistsChecklistIDItems(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistIDItemsOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistIDItemsOrder(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsChecklistIDItemsItemID(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistIDItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistIDItemsItemID(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck(w, r, tripID, checklistID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklistsChecklistIDTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklistsChecklistIDTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistID string
+
+	if err := runtime.BindStyledParameter("simple", false, "checklistId", chi.URLParam(r, "checklistId"), &checklistID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "checklistId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklistsChecklistIDTemplate(w, r, tripID, checklistID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDCollaboration operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCollaboration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDCollaborationParams
+
+	// ------------- Required query parameter "participant_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "participant_id", r.URL.Query(), &params.ParticipantID); err != nil {
+		err = fmt.Errorf("invalid format for parameter participant_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "participant_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDCollaboration(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDComments operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDCommentsParams
+
+	// ------------- Optional query parameter "activity_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "activity_id", r.URL.Query(), &params.ActivityID); err != nil {
+		err = fmt.Errorf("invalid format for parameter activity_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activity_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "link_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "link_id", r.URL.Query(), &params.LinkID); err != nil {
+		err = fmt.Errorf("invalid format for parameter link_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "link_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "parent_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "parent_id", r.URL.Query(), &params.ParentID); err != nil {
+		err = fmt.Errorf("invalid format for parameter parent_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "parent_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDComments(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDComments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDComments(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDCommentsCommentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDCommentsCommentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDCommentsCommentID(w, r, tripID, commentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDCommentsCommentID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDCommentsCommentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDCommentsCommentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDCommentsCommentID(w, r, tripID, commentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Last-Event-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Last-Event-ID"})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDEvents(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesExpenseID(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDInvitesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvitesBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvitesBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDInvitesBulkParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvitesBulk(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDJoinLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDJoinLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDJoinLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDJoinLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDJoinLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDJoinLinksJoinLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDJoinLinksJoinLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "joinLinkId" -------------
+	var joinLinkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "joinLinkId", chi.URLParam(r, "joinLinkId"), &joinLinkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "joinLinkId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDJoinLinksJoinLinkID(w, r, tripID, joinLinkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDLinksParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDLinksParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDOverview operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDOverview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDOverviewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDOverview(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPolls operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPolls(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPolls operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPolls(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPollsPollID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPollsPollID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPollsPollID(w, r, tripID, pollID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPollsPollIDClose operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPollsPollIDClose(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPollsPollIDClose(w, r, tripID, pollID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPollsPollIDOptions operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPollsPollIDOptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPollsPollIDOptions(w, r, tripID, pollID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDPollsPollIDVotesParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDPollsPollIDVotesParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDPollsPollIDVotesParticipantID(w, r, tripID, pollID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDReservations operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDReservations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDReservations(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDReservations operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDReservations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDReservations(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDReservationsReservationID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "reservationId" -------------
+	var reservationID string
+
+	if err := runtime.BindStyledParameter("simple", false, "reservationId", chi.URLParam(r, "reservationId"), &reservationID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "reservationId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDReservationsReservationIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDReservationsReservationID(w, r, tripID, reservationID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDReservationsReservationID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "reservationId" -------------
+	var reservationID string
+
+	if err := runtime.BindStyledParameter("simple", false, "reservationId", chi.URLParam(r, "reservationId"), &reservationID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "reservationId"})
+		return
 	}
-}
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDReservationsReservationIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDReservationsReservationID(w, r, tripID, reservationID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PutTripsTripIDReservationsReservationID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDReservationsReservationID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+	// ------------- Path parameter "reservationId" -------------
+	var reservationID string
+
+	if err := runtime.BindStyledParameter("simple", false, "reservationId", chi.URLParam(r, "reservationId"), &reservationID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "reservationId"})
+		return
 	}
-}
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDReservationsReservationIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDReservationsReservationID(w, r, tripID, reservationID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// PutTripsTripIDRoute operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDRouteParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDRoute(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.GetTripsTripIDTasks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -519,12 +6406,20 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.PostTripsTripIDTasks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -537,8 +6432,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -549,8 +6444,39 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDTasksTaskIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDTasksTaskID(w, r, tripID, taskID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -563,8 +6489,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -575,8 +6501,39 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDTasksTaskIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-None-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-None-Match"})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTasksTaskID(w, r, tripID, taskID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -589,8 +6546,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -601,8 +6558,39 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDTasksTaskIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDTasksTaskID(w, r, tripID, taskID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -615,8 +6603,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTasksTaskIDComments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTasksTaskIDComments(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -627,8 +6615,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTasksTaskIDComments(w, r, tripID, taskID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +6637,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -654,7 +6650,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWebhooks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -667,8 +6663,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -680,7 +6676,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDWebhooks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -693,8 +6689,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -705,8 +6701,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDWebhooksWebhookID(w, r, tripID, webhookID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +6723,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWebhooksWebhookIDDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -731,8 +6735,27 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDWebhooksWebhookIDDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWebhooksWebhookIDDeliveries(w, r, tripID, webhookID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -745,8 +6768,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -757,8 +6780,24 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryID string
+
+	if err := runtime.BindStyledParameter("simple", false, "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "deliveryId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w, r, tripID, webhookID, deliveryID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -886,17 +6925,95 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/exchange-rates", wrapper.GetExchangeRates)
+		r.Put("/exchange-rates", wrapper.PutExchangeRates)
+		r.Post("/exchange-rates/refresh", wrapper.PostExchangeRatesRefresh)
+		r.Post("/join/{code}", wrapper.PostJoinCode)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Post("/participants/{participantId}/decline", wrapper.PostParticipantsParticipantIDDecline)
+		r.Get("/participants/{participantId}/itinerary", wrapper.GetParticipantsParticipantIDItinerary)
+		r.Post("/participants/{participantId}/resend-invite", wrapper.PostParticipantsParticipantIDResendInvite)
+		r.Post("/participants/{participantId}/revoke", wrapper.PostParticipantsParticipantIDRevoke)
+		r.Get("/participants/{participantId}/tasks", wrapper.GetParticipantsParticipantIDTasks)
 		r.Post("/trips", wrapper.PostTrips)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
+		r.Patch("/trips/{tripId}", wrapper.PatchTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/activities/{activityId}", wrapper.GetTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Delete("/trips/{tripId}/activities/{activityId}/attendees/{participantId}", wrapper.DeleteTripsTripIDActivitiesActivityIDAttendeesParticipantID)
+		r.Put("/trips/{tripId}/activities/{activityId}/attendees/{participantId}", wrapper.PutTripsTripIDActivitiesActivityIDAttendeesParticipantID)
+		r.Get("/trips/{tripId}/balances", wrapper.GetTripsTripIDBalances)
+		r.Get("/trips/{tripId}/budget", wrapper.GetTripsTripIDBudget)
+		r.Delete("/trips/{tripId}/budgets/{category}", wrapper.DeleteTripsTripIDBudgetsCategory)
+		r.Put("/trips/{tripId}/budgets/{category}", wrapper.PutTripsTripIDBudgetsCategory)
+		r.Get("/trips/{tripId}/calendar.ics", wrapper.GetTripsTripIDCalendarIcs)
+		r.Get("/trips/{tripId}/chat-channels", wrapper.GetTripsTripIDChatChannels)
+		r.Post("/trips/{tripId}/chat-channels", wrapper.PostTripsTripIDChatChannels)
+		r.Delete("/trips/{tripId}/chat-channels/{channelId}", wrapper.DeleteTripsTripIDChatChannelsChannelID)
+		r.Post("/trips/{tripId}/chat-channels/{channelId}/test", wrapper.PostTripsTripIDChatChannelsChannelIDTest)
+		r.Get("/trips/{tripId}/checklists", wrapper.GetTripsTripIDChecklists)
+		r.Post("/trips/{tripId}/checklists", wrapper.PostTripsTripIDChecklists)
+		r.Get("/trips/{tripId}/checklists/templates", wrapper.GetTripsTripIDChecklistsTemplates)
+		r.Delete("/trips/{tripId}/checklists/templates/{templateId}", wrapper.DeleteTripsTripIDChecklistsTemplatesTemplateID)
+		r.Delete("/trips/{tripId}/checklists/{checklistId}", wrapper.DeleteTripsTripIDChecklistsChecklistID)
+		r.Get("/trips/{tripId}/checklists/{checklistId}", wrapper.GetTripsTripIDChecklistsChecklistID)
+		r.Post("/trips/{tripId}/checklists/{checklistId}/items", wrapper.PostTripsTripIDChecklistsChecklistIDItems)
+		r.Put("/trips/{tripId}/checklists/{checklistId}/items/order", wrapper.PutTripsTripIDChecklistsChecklistIDItemsOrder)
+		r.Delete("/trips/{tripId}/checklists/{checklistId}/items/{itemId}", wrapper.DeleteTripsTripIDChecklistsChecklistIDItemsItemID)
+		r.Put("/trips/{tripId}/checklists/{checklistId}/items/{itemId}", wrapper.PutTripsTripIDChecklistsChecklistIDItemsItemID)
+		r.Delete("/trips/{tripId}/checklists/{checklistId}/items/{itemId}/check", wrapper.DeleteTripsTripIDChecklistsChecklistIDItemsItemIDCheck)
+		r.Put("/trips/{tripId}/checklists/{checklistId}/items/{itemId}/check", wrapper.PutTripsTripIDChecklistsChecklistIDItemsItemIDCheck)
+		r.Post("/trips/{tripId}/checklists/{checklistId}/template", wrapper.PostTripsTripIDChecklistsChecklistIDTemplate)
+		r.Get("/trips/{tripId}/collaboration", wrapper.GetTripsTripIDCollaboration)
+		r.Get("/trips/{tripId}/comments", wrapper.GetTripsTripIDComments)
+		r.Post("/trips/{tripId}/comments", wrapper.PostTripsTripIDComments)
+		r.Delete("/trips/{tripId}/comments/{commentId}", wrapper.DeleteTripsTripIDCommentsCommentID)
+		r.Put("/trips/{tripId}/comments/{commentId}", wrapper.PutTripsTripIDCommentsCommentID)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/events", wrapper.GetTripsTripIDEvents)
+		r.Get("/trips/{tripId}/expenses", wrapper.GetTripsTripIDExpenses)
+		r.Post("/trips/{tripId}/expenses", wrapper.PostTripsTripIDExpenses)
+		r.Delete("/trips/{tripId}/expenses/{expenseId}", wrapper.DeleteTripsTripIDExpensesExpenseID)
+		r.Get("/trips/{tripId}/expenses/{expenseId}", wrapper.GetTripsTripIDExpensesExpenseID)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
+		r.Post("/trips/{tripId}/invites/bulk", wrapper.PostTripsTripIDInvitesBulk)
+		r.Get("/trips/{tripId}/join-links", wrapper.GetTripsTripIDJoinLinks)
+		r.Post("/trips/{tripId}/join-links", wrapper.PostTripsTripIDJoinLinks)
+		r.Delete("/trips/{tripId}/join-links/{joinLinkId}", wrapper.DeleteTripsTripIDJoinLinksJoinLinkID)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Get("/trips/{tripId}/links/{linkId}", wrapper.GetTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
+		r.Get("/trips/{tripId}/overview", wrapper.GetTripsTripIDOverview)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Get("/trips/{tripId}/polls", wrapper.GetTripsTripIDPolls)
+		r.Post("/trips/{tripId}/polls", wrapper.PostTripsTripIDPolls)
+		r.Get("/trips/{tripId}/polls/{pollId}", wrapper.GetTripsTripIDPollsPollID)
+		r.Post("/trips/{tripId}/polls/{pollId}/close", wrapper.PostTripsTripIDPollsPollIDClose)
+		r.Post("/trips/{tripId}/polls/{pollId}/options", wrapper.PostTripsTripIDPollsPollIDOptions)
+		r.Put("/trips/{tripId}/polls/{pollId}/votes/{participantId}", wrapper.PutTripsTripIDPollsPollIDVotesParticipantID)
+		r.Get("/trips/{tripId}/reservations", wrapper.GetTripsTripIDReservations)
+		r.Post("/trips/{tripId}/reservations", wrapper.PostTripsTripIDReservations)
+		r.Delete("/trips/{tripId}/reservations/{reservationId}", wrapper.DeleteTripsTripIDReservationsReservationID)
+		r.Get("/trips/{tripId}/reservations/{reservationId}", wrapper.GetTripsTripIDReservationsReservationID)
+		r.Put("/trips/{tripId}/reservations/{reservationId}", wrapper.PutTripsTripIDReservationsReservationID)
+		r.Put("/trips/{tripId}/route", wrapper.PutTripsTripIDRoute)
+		r.Get("/trips/{tripId}/tasks", wrapper.GetTripsTripIDTasks)
+		r.Post("/trips/{tripId}/tasks", wrapper.PostTripsTripIDTasks)
+		r.Delete("/trips/{tripId}/tasks/{taskId}", wrapper.DeleteTripsTripIDTasksTaskID)
+		r.Get("/trips/{tripId}/tasks/{taskId}", wrapper.GetTripsTripIDTasksTaskID)
+		r.Put("/trips/{tripId}/tasks/{taskId}", wrapper.PutTripsTripIDTasksTaskID)
+		r.Post("/trips/{tripId}/tasks/{taskId}/comments", wrapper.PostTripsTripIDTasksTaskIDComments)
+		r.Get("/trips/{tripId}/webhooks", wrapper.GetTripsTripIDWebhooks)
+		r.Post("/trips/{tripId}/webhooks", wrapper.PostTripsTripIDWebhooks)
+		r.Delete("/trips/{tripId}/webhooks/{webhookId}", wrapper.DeleteTripsTripIDWebhooksWebhookID)
+		r.Get("/trips/{tripId}/webhooks/{webhookId}/deliveries", wrapper.GetTripsTripIDWebhooksWebhookIDDeliveries)
+		r.Post("/trips/{tripId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver", wrapper.PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver)
 	})
 	return r
 }
@@ -922,30 +7039,244 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Raz27bOBN/FYLfd5TjdDcnAXtom6LwItgGRRd7KIqAlsY2E4lUyZFTw/DT7GFPe9wn",
-	"yIstSMo2Jcs2rcSbOr20tiJy/vxmfjNDek4TmRdSgEBN4znVyQRyZj++VcAQXifIpxxnH+FrCRrNH1ia",
-	"cuRSsOxayQIUctA0HrFMQ0QL79GcyiQplb5hdt1Iqtx8oilD6CHPgUYUZwXQmGpUXIxpRL/1xrIH31Cx",
-	"HrKx3WTKMm6W0Jgq+FpyBSldLCKKHDMwL3TeYxGtv8WfPW2Xm39ZKSiHt5AgXUQbftGFFBoOdAyrlg/S",
-	"mmfKkqcbTmmq6a3drt8VF3fdMHu8WyNaqqxul+KdsY7MZhtYOS2dpH1e6IRQxsVdF3Sqddt1+qR40Q2Z",
-	"FDRywczb5mvOxRWIMU5ofNHZuTkXv1xYIyBnPNM3KG+4mHK0/uIIua75wL616YTVA6YUm4WLT/kUIren",
-	"1UGkx2ILeS9A3ThR+w0KNmCtuxMgWP7Y5NHIFB7HDY1Y9QPKl7sGoiUsapbW/bov6DslIipedEnEal2b",
-	"Tu+UkmqvGinoRPHCpRt9w1KiqrRtqpiD1mzcgntTp+WLbUq9BzR0pR/BV7qWs/9XMKIx/V9/XeL7VX3v",
-	"N4W9tmnbTOM2btNByrv9DrOAh4C8tewHVp2mSU7GnmLyHtAEcFXzOejHVf2lvYFAtYv+UCKoMNg8sQdZ",
-	"NxBiKeIoSB7aHe4AfxeqazEHWe85+PlQ9iDYQDmijuDDfNekfmapPCw0LgFNEXgEgQc6oCHIPPowvG2l",
-	"9gP0XW5ztG7r4M5lEYXmCNc3iRQjrnJIvbgfSpkBE7RDu9CaKyGdQE2VHd6/Zgp5wgsmsGvIFN4WhyZR",
-	"m/gwnqxJPdDALkQR2oyuoqVDdCz7UVFmGRsa7kRVQlBMVA3eUqe98A9sf+g5p9uUc7QWvWHj9pb19yL9",
-	"nue0481I39PksQmM2YOLkaxc7PXm73QBCR/xhD389fAPaJIy8vp6QAqmGJFkyJK7HojUPGZF5l77U5Ii",
-	"Y0KcgSKJFBpV+fB3ykhaKiYQiCS/Xf1BfpWlEjAzKz/K5A5QA8OzVXMR0+UeNKJTUNrp8+rs/OzcdjgF",
-	"CFZwGtOf7aOIFgwn1k19n236c+/bIF30q0xzXIjJxB6mFaCsx8w0RK/NY5+JvM+Dy7fVeiNQsRwQlKbx",
-	"5znlRj+jxDLBY1oTTX2cHFU4fg0ZwL6YxY4PrY0/nV+Y/xIpEITLosL631jRv9UuP9b7gyhzEx2GrEwA",
-	"1EnLBkAd+EsYsTJDsiozi4henJ8fJHRXSXGDYotgfxq0mVPmOVMzGtPK85ow4jmWSEEYMX2LDR6bKs2C",
-	"Y/bpm1dcCZSOdxqoS21LkK5wAo1vZDp7MoM3j6gaqWuB2ID51VEUWGJ6GrhbxQkjAu4t0B7ODlQP4P7c",
-	"nU4sjCJjaAG6ajW0+WdwGZTH1YHH0ybw0/l0yyxxGui+B6zyl6TOgLMWfCNalG1JWz4blk/PEJvNURBD",
-	"/HiFwDmqhfW3s0G/fnRQEUNd4KcJ10TJEoHc8ywjCrBUgrAsIzgBYmRqMgS8BxD2iQ3aVYdFmEhJ1WO5",
-	"lyMCU/uq1GZLnMgSyVoRo/kualqfWbwgkmo56Ts5nqpDuAw+/8DH8NXuLuNZIT5Wd9O80n6WDmfj/vjE",
-	"uhw/xGZbA6yF4rzJJqDxOWSOOQq1/LADzApjkRJthmfo5YxnxN4CWlV0YFFz94YhQ43DfFC9f9pcs/U0",
-	"7Ah08xLCzvmLaJmDFEBQrpqXkIl5HW2re9AAdrFXli+kbanfHZ9ct2Jh85Gu7ppDe5T/HspjtSf+L7ee",
-	"pTWp/WjqFNsSEzptodTCFs2LpgDS8M9cX9DI03prd3I04uO5q24sFv8GAAD//6I5Fyf6KgAA",
+	"H4sIAAAAAAAC/+y93ZIbN5Io/CoI7kZ4N6b6R7I861WEY6etH49mJEufWh5vxNhfG12VJOEuAjUAqimu",
+	"om+/B/he4Vycq3N5nmDf5DzJiUwA9ccqslgku1st3khssgpIJDITifz9NIrVLFMSpDWjp59GGkympAH6",
+	"43ue/MAtzPkC/4qVtCAtfuRZloqYW6HkSabVZQqzP/xulMTfTDyFGcdP/6xhPHo6+qeTcooT96s5eefe",
+	"Gt3c3ESjBEysRYbDjZ7irGzip72J8M/38I8cjL1tILSf9iYaPVNynIr4VkEo5ryJRi+VvhRJAvI2ASgn",
+	"vYlGPygJtzk5zXcTjV5JC1ry9Bz0NegXWit9m2CE6Zmh+RkQADfR6EdlX6pcJrcJzI/KsjFN6gB4oxIx",
+	"FkAwLD85C7/eRKN3fJEqnnxQ6jXXk1vdSD81s0qxlCZHeDTESiYCn3nJRQq3isfq7Gzspr+JRh+UesPl",
+	"wksbc5sQfVCKzbhcBJljRtFoCjwBTWC8B6sXR2djC3p5r89pMYZZxeZcWHYJY6WBaXxHyMnxKKrAZxcZ",
+	"jJ6OhLQwAY2g3ESjn2SmVQzG8MsUXkgr7K0K/Nr0DNz8BJbJs0xpC8kbSAT/QLDfJlzF/GyGADDCHj7o",
+	"38bBz2IrroVdnFkLMgGCkCeOuHj6TqsMtBVgRk/HPDUQjbLKV59GMOMixQ9jpWfcjp76b6KwVcZqISeI",
+	"D5HUnstzkbQ9JvkMKlsdfriJRkhcQiOv/X1E79KjkZ/x12Isdfk7uHPnLEneqTR9SygxlXN4gxUq9zJ+",
+	"FBZmZu2OFBO+Lw9gDxnXmi9G0ejj0UQdwUer+ZHlExrymqci4RafCuuMZkJ+9yia8Y/fPT6NEnENbpur",
+	"eAjQ9Vm9U40GLf9VUkfA2l2sLbgd6FdJO9jf8zRV9oW0erEW1jrFn7FrZSFinGkur5jS7FLZKRsrzZQE",
+	"5qY9Zu/xx0dMGGanwMb8WuVaWDgeta/9og/h9t5TepsQwuUVPjQTUszy2ejpo6gp3tYNqma4IZldOEqh",
+	"YRED60j0b/hM+6bgYrs3ZRgHIUhmWfC/hyzlMbhdyLi2IhYZl/YrwzIN10LlhvbTMCXdMypNj9mZZLRm",
+	"lgpj2VzYaaL5nEaZ4Rb2YtIqjQ1mz1yKf+TwneOvV887ONStvhWneTIB+1rITXmSxzbnactRms+YGhOu",
+	"4GMGeA9iqZpMIGFGsTHXiB/4yGdZipA8enz8zSkSPbcWNA7w//799Ojff/3Dv/zyyzF9+vQoenLzr//x",
+	"z20czlPQ9sJONZipSolFZJ6meAiOnlqdwxI530SjS1pyi8KXpymbT0EyqZh7CPnTgCXuxRXF3MJE6UX7",
+	"GppTD1tTmGMd+bxw2H0WHr+JRlnKpWzTZV8YK2YcD+FYGRv2B0dK8hQSxt0BLMAwLpPwuwbU2EkzMLvc",
+	"NY2HpcQ/lgB15MhmQuaGORJjJgOZREzChFtxDUzJGJjCm4TbpDpoRz124+g/ekPbYKRicwoyWibCch+i",
+	"wCXVNXdz4TDJ1sIDDVUddAzS8gmEjU3FTFhmp9wyq8VkAhr3nZEOgzowPqPmEvQxew5jnqeWVONvTxHV",
+	"M/7RnxWnp9EOTw7SMR6dnhLO+UzlsoVHXxPkwsliq0X2lWFTNQMW51qDjBc7o9PeEtiDuiRz/ffd2/1B",
+	"WZ6awWJ3V9xYisN9SbSKWNqLCLkt5i84fgB/p1ev5LWwUOHxfkpC+aaaLyu19aFL5bpONLEGbt0GLB+H",
+	"Se7uf9D+s5BE9O0/ajU3Q5YCJk/tWhU9gF2FsQTIz74O3TTTEkaKK2Pfqx9Cxv2tePknNV8WVY+OLrmB",
+	"hGXKOCtJOFXVPAiwPEOzTsSksixGaSHkhHH27PxvzFkujkdtCoyx3OZuGTKfrcfUr+sIG+GPikuzH34N",
+	"YtV8Q8m1McobQHbfsJ9NuX02RZZMN4TJ4+2C29rtKuEWjqygi/0StIkgI0vSZkWyTjmhi0LKLQ7IDBcJ",
+	"fSNkrGa4w3O4nCp1hcrlREmobPKlUilwMhbDdfAnNFQ5/J6sKAZJy0ISMbgGvaDvnP5Kp2rve8gHLTIa",
+	"lYxDLSKmp9Ek5cZeQLAu16H+ebpwmgc3lsD2NsOIoaR2WOPuB5PHMUBCGmeHMt+DWwP61y0eaeddeHaV",
+	"gacYsNiaCinUFh9VyWoNvQ5T+frQhlWEzmXiSGFsmcotmiZ2TChhNxo6G7+ElFlIUyR+uka5xRvGM64R",
+	"xOPJMfunVJhLJY+sFpnXM1+DnNjp6Onjb74ZrJ6R5eqbb2hvhxFFNMp1y0X3QxtL//T+9TF7ZdksNxbv",
+	"Tyq9BtwKzrL8MhUx40miwRBxlwylRWO9p0++HW7c0ak31z35Fv+gRcF3U2szhv+YZVV1mcRxxV2k+66C",
+	"xSVv14wX18smcqJCJhqGSOdWoLEarSfsPOUxGcueCxMrnYR3DJsrfcW4YXNIU8RaOPIMvuF4EF9oOeQQ",
+	"WoivUrExf/UUeAXTNPxd+LU/4oVmcQCCKe2P9F7cVgCP47VxW8Vc5c2DdTje4g0ON4OzDLTBlZfAeMFL",
+	"EsGxZIASTR5TriGp06jDwVqBbIVN+5rO3bNLCwn4aae/KlI2vDoZIyYSoMOWunZphKKaFl05rv2PqzSJ",
+	"tRP0JDsLH21fDOOjUW3l5TpqQK9F9kDjRB3nTbdmse/ILvGU69JGgTTQRoGbScXSxlDYvAP6ylvjO25M",
+	"prTdx6HT2BWafCWu3+oE9DBk41IvRNKuGOgFoTSgtyII/GVEwrxFQm3mZOmNIDRThy1pEm5YxUo0DcPQ",
+	"OpFZpcg2qckuIVVygtrVMXsN/BrplJQptBDzyoNOhLJLp/bOpyp19qo9kfQMj9Z2Lvvgf0Q1JFbZouAu",
+	"w8ZazSLnlnJUEQYyzPDrEnwE3BsE9wJ+ODOqLBlfoepAh/ctsCVBsJLgAhr3rEoUH9Z4M4edtD0O1rDO",
+	"YQxWAFWnwaoVmQgKH1sSRdtr/RWTcnWrl1erZjOQmy7Oe0kWQxUIntup0q1M+mNxC0aMuAfdTS3w3yBt",
+	"7FIli3WTxQ4XqPclkIJ1it96bWiI6cSN30Yf9EOAxTBjuXOuQsLmU5ESqAs2RYmrIUsFmAj9iXaqgSeG",
+	"XQFkXt82U5512VQSYW9HR0uFvBpKJoiBEO3ReTwZ5h+DJBzgHnlbHN/uUgHF4bgx6Lg1i4s4+G6WIqVa",
+	"RFPJFFGNwUocVoHyJF1BUn3SGl1WN7wkvl+75cFAHbcuFhrhIP7HJqvxS5XboEOgAkFRIpWhyBHrUeCO",
+	"bveuKn1fTFgD6XjXZ3IEH+M0TyC5wKv5d6+FvHr1nDZvhQCrak5zLWxh6imJctfBK+3C7Y0jjGowh3E2",
+	"hj+xsUpTNS/0GqGdy9MboP7EJf+TV0GOYzU7Zm8KFquNxjWgyZ4iQ3EoGqRxeD05PT0dvEQ8vHAAWmZF",
+	"lDR9ofKqnaz2oaTVBEMzytrNfwm46048J6Qiv6fPDmMEGsFr+MwRcFQQPJp+cKFo6XE74+bbw1KabtqK",
+	"/CGKapUPJFQCLw8TEzHPeOzDQhsk6zzqTOazS2+x8YGQpuWWIZXz3x+Pdul9pz2GECZygWEi6wxUb5QE",
+	"OjNSmLQTKExKp1duvW5TCER+hS6MlGM0hyytpiqOc82SXAcRksKkuKSmMPnKMDys2X9518nOKZ0AMP0V",
+	"m75sXb/rDB6jGTJXQButusY0CHhQGGbYuld9dKAmm5XvdsNXc4wMAtF7FoZAWL66CsCaRWwQiEhvQ+Dz",
+	"7/UAbjDuwuIGYa98uQeI5S1zEKjBTjEE0sq7KwANCuEwTLq3B+GxeLUbOB/5txNtdefSs4zcuqMwrK0i",
+	"KEMYWYtX5/wte/L40b8VkWYsVgnUw81e/PS+rgV+TUd05a+BiyvAWsqw+LQTvyhfgN5TiLnJUG3kdrVR",
+	"SKp57SxfaUYwWSrsxUwla4PMz/HJN/hgeK1/uJKnDhpih1kUGDXpg7Yrd6bOyO1ia+r7XvBZhWRriCmW",
+	"20OMDJJxPrh7iIwrX+0G7i9KSLzgDBNy8DETGnaow5WCbpzbXLvMhRn/eJH7PN8da+JapWuJu0I+7/Hx",
+	"m5se2Bx4niXtUTa/+3GHUEHl3cjN0E0Nwylhe327CP9oxGtsEZ7RLyojGO07YzEqqBm0renAnfPvdcOE",
+	"GV/DtutKyGQt3as0/atwGbz3LDFu6/tdt4uKMFN6clbl3FU3YRBhYJLTEMLw73XD9L7MKBkIWiUnZQiE",
+	"9de7Af3AzdW9vgMggAMhs9wMYnv/3gqYtMj2bxuLlRwLPWsYZRtB1JUMlts0oCVgrJA8qOYV3f/JcGOw",
+	"kN89odHJzmwurLoQFIPdng3bmfs84x9fuae/OV0Sek4l9b87784GEuuboNNeOBhdfAd9dpDLZA8GtWhi",
+	"xwLS5Ltzy7U1Z+72h+lAF33ucSoBlwPl9VFmKSGHjNWxktegrTdj10jIKu9tdE9noDuSj3Z9GywJrnYd",
+	"JDK/6JsD3xu35ea5CdojrTfSowxt0x7ooNDJGxKryo7V6UuCbGGq2oLr6F0n+oaJYy2yQeLYvdcN088u",
+	"hHYgWAZi3ZYl+1dYBKP+n9+cPTs6//PZ42/+yDDMj+MuGLLkC0yYThfMTNVcUqzBcZtI8lG+Q5ZfvhoF",
+	"WFtR4Vnle55yGcOm6XaXldf6KZjlmeSnbPOyV8XTElJIsPRLrCaviDC7z35EMDSXZuyrpyzVo3FhGiQ+",
+	"DVibgs8z8AiLGMfqOejOkcDGMAdMoOaymWfvXbOcSSWP/gu0CgP0DpM+p9l/yj54cNdnlZX2EofpqNzl",
+	"6qrbqOnFR/QSTOD95oFgl9y036FhPAa0ycKFkjU2SFwW19IL/8iVbR9Ke7Aau6VFTMFWuBUIBpINDdIg",
+	"l+PTb588Xkkvf+igFaNyHbdMHRLcfPo8itRAw5lW1yIB9wMCzmL8FUMSj9dnYiIyAyb8uhuYLIBat4/D",
+	"NNawnffVSNykqkaihtDGsoQvSuxTGSBwka2vLMNUcsNQm04ZZym3oN1zoRQCOfEzLvSS3XY7B2lB3fcQ",
+	"s5EEp3B+j7t/c2cc1xtwAu9mAPesYxrzE3H2ppd3bmETA/wyk24VcL6MCQdQ+2LpiL3tCNHdOs925wuz",
+	"y6GcK5xQ/eMk+3ueGq6k++opWqt++IDGwqtTj3ns4+PxSIiqxUj6en6a29ySqMst1QJxD7I5Nyjyj9mL",
+	"oHNaF9yFDwXYWeJcefR1UUomclYYOwVNPj76VE3hS1UyceJsrFQSlC+fiVOOM4pG9Gpril8N9xsy686Z",
+	"bTm9ZD1NT7l24KwrXLTkGGwkzfmRolWFR9p8mkOR1iyk5UBzGYVEgWSIw/DIjzy2zL1obq0+S2ktqUQG",
+	"bLpBA9zdxX72xo97pVlaZy+1dU7bHMw1lLRRzQ9gdxQ5tk6S/gAWLSlnBe+H+V5JCfqsVaAWY3eAHm7+",
+	"vUFvVjF2r9cNfeznkDhLgdhTbhivFyLCHzVQTC4zQk5cWU7MwpNMFGn5Qcy60NnS6shtqZn7CxNq68KS",
+	"QKYzYLlUoJ9abKBjLZlHet6eBZhOfPtaVsO8Ju5g2mQJlQp2aywty/n7bRWkIkw4iad+g5zwwG2smYRb",
+	"DSbBbrMeWl/6aYVpooKIMHIHvitBk2a7qMkNyKaccz3FhME74d9R2GLvhPru4MW1MIa4RbNl4OImqG5M",
+	"vRbh5RzrVmO2RfmAZfSgl2LwLvh9lth2ntkNYHcvtFY8gY8WnU2mregN5pVjQoP7HVXgCVifa/3RsoxP",
+	"oKh/U6mOwyfQIwOv3WdsRnWYOjBYu8oPRGNPa+XwO/9aSqE7kl5F6TsJcet5/esKc1sDm9kOuI1vqWux",
+	"WgzcAXeIJRsKOMZ9XWAIUX/Q22Y9Cyy4cjGVyfouxw28owC5IVmyPYIXd5WmWo1gXF84V8O12rbGyaDA",
+	"xmgUgOyTURo7KwjN5N9cW5LrB9Igtwmk60HDbfTbElq3CkCzBYQb8dsAXlvJZluzWE+K7qqK0C+Kc2Xx",
+	"hK44zB/Abhlr1ydqsTXYbhU8ZguANguoXEsZbsgOWHcaE7gO3Mpkq2IDe8Bqtge2P5ZrYK9Bdm2CjnVs",
+	"ET1Y1Z5b04BdWRln+00TMLZ/fcEy6LK14Ak3V32GaA1cpHPBQ74CKWaLmMr+2+mgXHeNoyG7YG21kg2z",
+	"ym1ibOmc+m1uCwPdmoVVpt1odRUb4IbrDNnUvZe51JCmzbJUiVldrzsNTK3uW26kyMDe2OW4abbzwMpD",
+	"5TQVzEWVrSkWsRFNVMju7mi/apxeppPEhwj0w23zeGh6EcpfGcUzotnYWw9w3N6ydpMTxV/tax65XqeM",
+	"FtlzsFwM1kWsFlnPbWlMhF+9vfy9NWpyA3jDMMPD2deLhg2CxjcOpW6Lh97V5VGYiyIWv70oJlV9aK0L",
+	"YYqKc1THRjKr+TWkrgBh5JvruJp63nlSwVJRD6t31eLX0FrsaNOA5FYJ1yfIuIaqmgCs705A2QoCfXsN",
+	"+lrA/J6f+dF+rpw1F+7GS6jWz1o7kclnM677uiyb23Lu376JnMjZvRBroKIhnx32y1VsQFHn5cIHE9ay",
+	"lCvo/6K5g8vPFqSz/NO6l7u922bUCUQr7lZgrI2Ohlci3ScZr0LHhgscomVt3JlxwCETElP2ZXrEhqCp",
+	"MPYiNPJY0erDFzNyNV/9e9VK25ijFr5fWWdxpYGz1nJy6XDxds9lsDv226eJPIdUXIMefptMigF603N9",
+	"6vXkW5li9WKGriFUnd90BWshLwZug9t1VKmS3rCiB/tKAevdiAV9KcOTQPeYwrZth5lyYdsK+1c9zdgD",
+	"k8LqM0WrksScjeFOQyYHFfe59xV62vvAVSBv242WvLHNtqazE45sy+J7x0XiuyxiQcxj9o5OiWsoO0Jk",
+	"1er87jE2Q5rp6Lc4rMUaJljutjVcxkVyp0G96wJ1Q7cVTn/R8t0uraGL9yqFroDt2m7N+IIlqlop9pi9",
+	"SIRV2rCYU6MFOSm6VMmIofYP/kdK29TAEyZsNVgbaIBRNHIPtwZjv+M2nm5yADRbJ//l/O2P7A3oCTAa",
+	"i/3L+5fP2L99/e0f/9UnKCVFCXFa1tuZsBYSRmk5LnWbqmbn0qoc25u2REl21hrA/l1WOXXNKqZhpq6h",
+	"bJjZrBdw/yw7K5djrMpCECOVSKAYREr2irn8ygayKMptl6GtrvGh2VSUrtXIh9hfljnEO1A3sc+lytxO",
+	"HfAt6tk0mvnoBHxDCWO5TIScuPyKFHhCRmB6ko2F3sDjVpbD2aTDQDSaCymhvRA0htXizyVMEbVQLhvj",
+	"qTSleFrahAFl7duuRI3qOOUGV2FdXTWn2IZOEYuAJxCLBMxTHySMdIVTlakwMyprS01qvaQSVkjQXC8o",
+	"D9a9l1CTjYLJGrVwK2I3jDtyjgTTLnfLbbzdfLVEzWXRWX1Z/q2TaLvisvU+rLUzYSf8i0yJVs/290on",
+	"3BWXKQoac4kNSsxT+ou4Dn+SnuYNA66lYZK5MaMyvlNJYI+O2fca+BVlU1FveRMrDR3tPvGnZaB+ygjz",
+	"XosrdqJjjLWStn9nr7VP5lknTbTxbj0Drs1h2G5UD9NUyTBgq76hXexerwS2obJSSX8jyeAFsARARcS3",
+	"OKFu6mElEeOe8ZdfKJZIr/hFLisvuy2kU2YJtVbSGeAa3vhEH1wxrW+rl3daXaYw23B3/VsscQZ5jOJ2",
+	"D1y6fhukln57+m8t6mVXKKgbqvUn0FrpDWKuHHAvccde4Ktth7eQxoZrpF32MhPJX3QYXcsGw8uSpFsp",
+	"cF9sGGFHv5Zc72deURByefGbHXlE563wz8AYPulAWN4n4sGN7Z8uB2xbxnvwxsztqvT4QQaVZK+82w5h",
+	"LapuoxAxskbTqxeBH9Y3FNokLgZkcpGquIBu7egbX652qOBXMBn0fFfOrhfkoVBKr4dJ/tYws1MRvVSR",
+	"CB2FhUpULjNCgelqP2FjR56CTLhuXCBfpmIytez1n9mT01P28v0Z+z//3//P/vLyr6MhKn+BpqiF/gqE",
+	"d+kSDbw1CGwNe6y8NVSwwi7RBfCUca/p06WcL1AtGBMu8JPVXEimRQKMwh80SIstCHlX6jy96ZLnBUIe",
+	"c33hXmq9K9TCbQfVamzj7ooZ4I9Ptuva9kdX7HArcdBQ1bQW1zxlXOhMaWp8bayn00BWFGzicGlIBSN0",
+	"mmP2XKvsSI3HLIzvOuqW2+IspsKyRIzHoCvhr5mIr47y7Ji9drtlXP8y6RuX7L7VXU3MNYJzMavuSOVI",
+	"Yx4dSrPEL65n7fXtCjNuLSobNYyc+FDas4x77pi9RQPm8k463HvUl0LISZ+GIevxdpvx9WNab1Vw10H/",
+	"s7KQRkiPqZAQMc0FXgdAc6s0rihQl5plXC72RSzLh0WDb1yv8iDfvcyJWAIZ1zbX0MpRSgfCL1hmT+3c",
+	"aydZG7kLz6wtkNP34zoJRQXgDRbfMXt0FnfudTa1HkYqH1rILIWJ6QjRa4TlHbMXPJ6yFCb+tloGnro6",
+	"YpmGa6HQpSSBrrCR47+i/xOedq7in6gUT6Ar8TE7kz7sj/rnxilwbcp3Nw33qxSMKuvePj4dXOy7Vua7",
+	"sXuEwhXbMixPJMRObhfk2AC1O7zwnF/DLtKCqXFwD9OPe64VkmZFx7su64MH+sWA2j5WXWzrPGybum3g",
+	"lVV/ytpWLSfRvFrtSRiWCDy0kqcMrkGmC+q8RlZu7UJvla9XQ4K+Wd3HFQTlScLyLDjq/Ng1X+I/cp5W",
+	"SxbRIK3q6gef9HOLff4bpc3WP593le7cmX37GnSStzp/wU59Dc0kdykA5LHLuDHV1rkMs4mYMOjnS7wS",
+	"tBxKV9p91mUvnbsnV9uDtMgGEb1IRuXb5Q2vURutssvFHlQMSAFnbQxRTTTbkLburIXzTlLLB+/GUpfM",
+	"tRnVtQ4KgyqN3ctms1U1dtsWr49bC3L1akjqEjgHobUuHbsRKyhQRE+KAlQoQvbScLMhcLdEceOuE9ro",
+	"llK60YWML4rVockqTxN2CSQk2eXimJ15fLngEg0zIZNK21/H8cJWpG5R7pi0W6ofT9aFXHrcJzSZWV84",
+	"d1uRXCpA3yvlegVfc7mPy1hr66Iuwj0v1rTkdJmECycvyC0oDVYlahSNhLzI/HPk+ZPQrjdokb247iPg",
+	"m+RgeSB4uHa96Jttt+l7ZqwGPlt2ALlQpLaay/i905Ccmp1Eji4yfwP6gC3uXfeJNJ/J4uJtckJfxOBj",
+	"DJlrYlIkTvqCgmoGSLFIXwaVL3pG1vsrA7+m0AR3LcNbSxnt5y5otaQsQ62ceYzhgS8Bo7EqwNAEwobS",
+	"85bu/3Xbyt9bfLm/Vm5xS3vWcRaWLq02ieWj22t7xqrXy1RNIreHfIJ2Im7Ya27sEVHH0avnLtTL5LM6",
+	"9CMqzNweaqA3PHY9zjrjZeq9sCPXCVvpWlBflW7WS+GN1K/Sdbfuikk4+4APt+ts3plXqG6VhUeBMeo4",
+	"bJURtZmefipFACpRjl2qgQPHXhWpftXyVAIpuK8Qv5WX6M/yBfqzfLiyCZV3qt+Wr1a/DSN0SSe8rm/q",
+	"7KuFE7b7uHqW5epJGN4i1XNQpH9sy10/e17kuIqT92qrjMrglfGfiqm6CKhiA9oKy3uwW/bep40K57cJ",
+	"l78CZO5+LwxpydRLnadKTlzjjUpHdurTXygoCsN/lxof4c2e4yB70QM3ILYNe66XZNlIGTj78azsJl9U",
+	"Wi0poBHyuoKSe1NCAU2/dkWb0P1PJIjKWr37bsVWKCG322VtYC2NskjGkq077LzTgipMsWiGZmK49CzH",
+	"MD48xFiS63D5JNbyikcKk69MSVh74ZZNo7M2ZJlt26Y2aLsaztd9O3AEvJXZIFzUG7QLEj+1tB76Exur",
+	"NKXslku6CQrtbnYRg+PJMfsTl/xPXgQcx2p2zPxYjQaEpLhLZcVYVK6HDc/Xk21NBk9aTQadZgKHz0P3",
+	"3E7UHFpW7rll5RfS+HGPWTQ7aRK5r9aLQ3outnHj35St3fTyzId1t16fQtb1hpbPNL3AzTDtdQQGlWq9",
+	"bi+FR7dXho8b5sMs0drjSsrj187wQhu1kWe7cgHvso+svdMNLoSJLxZrjir4XOsM8BuG5dRmmR1Qvm2W",
+	"bbo1Sa5diNqsKzklBA/3Caq0uSli3VrcPr64A9PeZ+562V3y+GpAjYfaeuuzB6jry1uB8aK0wiCUb1wH",
+	"IWxwW7m8oezVmy02YAEqke4X2Ro/VHfmhUhpZvI4BkD7P0YLcpE2bHEbJdT0M+03NjJY+dv406Gr4gMt",
+	"tnF5xX1ZtjFvRURnQFmIOF/ACqKCkLJKag90W1WFt2831WXCdyBXTfhqHGQvuWTGpbLFhDQWeMKoW1+6",
+	"cP1UOz3kfQS+VYFgliU++YNRmVB619Lfi/WlAAENVUxwDezd2/MPkJRXWQ1GpdcUj8xZll+mIkZLjwZT",
+	"91G5g6Lmnnvy7VaqvPPQPfm2p07frsvfULbLWLXsiskgFmMR8//+n//9v/Es5uzs3SvUwjlTJJ+PQCb4",
+	"Nc9S99j/UJQUL49RvVPSWJ3/9/9KON70ubTAFPvx9c/sLyrXEhb45nsVX4E14NK1/I1pFMYYRaNr0MbX",
+	"CTo+PT51ab4geSZGT0df01cUmTQlVJ2AD8I6KjonTFwFBxcgKpTEZI+lLg40huYzsKDN6Onfl/ycjmeI",
+	"PK1KuKM8/OUfOehFqEvw1HVVcFTXw+h782s0CqceAfv49NRHidvgfcsItwjHye/GXTPK8dfUu2oPSbtZ",
+	"chuH9bHymWj05PS0a4oC5pPveVIJFvymzyuvpAUteXoO+hq0T8GqlozD3fHBT/4+QJuJ5iHXZhP9iZz5",
+	"XXBcUu+tdBONsrzlYDqLY8iQj12tBMcDoYkyRU6qsZ+M0geenf+NjfEqQo9Ue3xGl9xARE1AI3yBaTXH",
+	"MEzm/nCuP4ygXrhveKqBJwt2JTGtY6kNLPkU/YLqlPouX6JUn3/2vbfV7IRY2tqT4qZY+GhPYnNdH6lJ",
+	"xjVJgwf2zR7pujvWcn+E/eTR1+tfeccXiLcPSr3meuKmevTN+vd+kibPMqUtJG8gETycUU8eP+7zcqZV",
+	"DMagtvRCWmEXO+RERwkNRuzkupuoKYBPNIw1mKkrjW9aJPE7ZZpiyr1xnyloOHbx3ce9qO8HbmHOF40N",
+	"eY3b0SIbvVZGtrJJrtFY5hMoVu4Wdng5+YS3o5vVW4Q1vJ65S1TjoKRTEA/g8hAMPURqIiFaIT9+3Y9Q",
+	"a1ZU6yWoHu1h+v3Lp9Mn61/5UdmXKnd5QU9O/339C8+UHKci9hKwB1A/KHlfJBcin3FfIGSqVT7BM55i",
+	"pnE+ihKpska9uCcxR/Wrk0+14nA3J94qTVyDxZZa2Aa/rtYCrXx+9fyZf78PPzXr0nUz1rrg1GV98/FG",
+	"5B6ur3g9x8tE/ZreQt8fKgFR4zxNo6XqaNiSM8tts/AnksLj0yf7he6z4b4dMYUnOtMoeaYCp2zDEAnE",
+	"qZBQPUbqyP5BYABdnjFecekY4Nbp2q3lXymszzX8C1/V/UBIV5lWM2WptARdwsca/Mgt6rQytpMnn/sl",
+	"3DVPPlCq3xER+10Kwl3Ia2GLcJOh1FuUmaqYDDokWbV5g6uZ56iyzOejzLuFy/OLmBFoCSVbFoXJVKIr",
+	"XQoP/j1bJlXsRNVFqa8KcO+aVndqr+hozPPwaDhYOKrRYzWx5iPJXCzyNoStwYBMjohLYLWO30lt72kM",
+	"V2j5IBzvi0L+uMcLH5R6w2UIZjM7pOBz8MnJpfz1OR3OEF6hZYoc346GsXVmt2bxnmqNmiXVsqgl4ULE",
+	"jSKt39eYAK5TAXoJfuNqfc6VvqKsdxftb52UJvtdGZ/E3tUioqYKjXmuGobSTrqT1dI3hfVBDpfgq6Mm",
+	"WGkVMTZVBsqnuKtltxGDEoIOrPmgtHW3q3Vm+srsSOEpeu51+Uc6iY06/T0wvaPWvPDhqhu06bU49Rpx",
+	"raGnwoPcrUJ8oEfWONPeQ5b6vEFjlYakjPwoMqrIM+Jtgi6ZymoBiXPEkJf5Ckon3BS4K17lCe9VArNM",
+	"WQzgOvorLGouudUpCXuzRT7T0IiYvGVrZBWAWyD0jbWZO7cYOgQxziTMmW8SFbjBkX6FDU4+ueYQN6tE",
+	"KHED/vPqeS956YbcSlBGSy5PaeagHd98ffqk5LAXHziWohFpymZoqXR+nXZ2Gh/9qCQcvcHnRmsN+fu9",
+	"HzZ7IvYk4q97it43KqEg9M9QwnuDiK+6etxCvlFpq25EbMHcF9elmBaK1HXRN2kzv6NRgMhZ6DB6uNI5",
+	"pF8sb+TLeXMNRQkUIX3nhBZb+n1gp5d4wyFmevLoMctlCsYMYKeenNTnGJqBnsAR7esfNmOopYYTt+zI",
+	"346h76mXrMcx9k5DrKQL3nvpIv4eQKgA3RF4mi58Sn6LS6EihnL7GQqh3B5E0PZsv5w71EvuHIwL24uY",
+	"+xBQtEY2LGvYJ/XmqB3OGWEK0ZCmTIPNtWQ8TYvm3oZdgp0DVN0vbW0D3MMUfCy9ZQ6ZDcOOS0BavTUV",
+	"4VA6MQ6K/907hr4c3b9OoYG3ym/d4bvafHNvKPgLthM1qxDcia2oBOIhaOb3yMBUZdVFJ6OuPAlPPoX3",
+	"vQ3KFcxZZuvn9H0rY4f9vVVdtmXgciWfs6L8RSirw3XPnQX/pLABD0V9bLNfDkc8KJ1w6PH0xWmDq9gj",
+	"y1vYo27oOBwYn5dlZZDueDiw7ruxZJcK40lRe6wZF7K1KnkWRq7FjDwUeXEIqLpHJ90H3oyOYthqisv1",
+	"rNLhgTgXE2q9nGfMzkVMLR04k+pIZcfsJQUHuuPh9N9L3SlMFlJcQgHrRpZCJe77K1MmOKzxMhxY7MBi",
+	"n1PMInJQgyeX62lvcoJd8pTLeHUphwq/fB8evyVu2PMdJyzngccmYuS0mlM5dl5JRyO3zFTNqW8OWJsC",
+	"y2tOoxAy3UE65OHtSzju4QdCNrSYB2G33f2t2JGFO8ip0AwkRGg8tjlPmclAJq4+IbcwUbomrtzLKwnO",
+	"nHwKb26mTrtdM8/8y3d7oMclFN1DtzRUVSpx7VSlyZS2o6gq4aORslPQo1/7cMRB+V2dHIAZJKRSeoKm",
+	"vh+rabZD7fWEx3y6SkVNrYXUhFAZis65BGbAsrHQxrnPa/E2Ia/Ft+Tw6S7H7Bn+jBo2D1BTao2g9BoN",
+	"ZqrSxKcRTRSV+iqSh1ZpyQfO2b0xKZwhByPS5+hrPPcZH/1lQ8t5FlqPH4u4O/TmhavtGE8hyVNIyusw",
+	"CoVa/26I1YxqYrlyehFzJbGsYmKGFB/y98O0jGfZukCbZ/7ZV/E9Uvld0SwP2drKWQ/qWHrxkXYy5OaH",
+	"NHXGad9F2C6qqlalwwqhdBHjlNsjPF4kpH1vg8+m3D4LrzwM1b66pC8gYy0OjZtwvU6MNSMH8ZFaXFNL",
+	"cVFTa85F+b+YyIs3TQ0MX3TpcH5KnA01IercTH3GZtxa3+celZUs5Ra/dYHHP71/zSjSKLPMQKzBaUQS",
+	"rkH76hDHv8hf5BHDICufp+xHcOUBqT59aJLnIphCazxD9STUMb7+lvqVuiqxBHX9FbrSwEeHb8FTqtGp",
+	"xuPIrfjrU4ROycT4BrPfslDjlsryyGoPsKILqroGTZO/AWP4BJrgA9ZQdHA03/g5jFk8bXxxDDZ35Wxx",
+	"nomSUEe8YYmgYzGJmLs9MeFQii10ROhFVms9tjKY7c7kwB7iw8ql3GlwWA2Oe2hnuHsV7IzaPVUFWFH3",
+	"syys3CnO1p2AJ5/8p02ddlVe8P/ftfOgWMnBtn+rdZR8KFWVRLekxBPri5O3n8Xu+NNiMrWMz/mCanJw",
+	"NhOmUrgyMIuZqrmrF4ZOuG9OH+PpgbaDeaVvd+UcGud4+OCXM3dSRZWzFejc8M1glJyAplIetcPomP2M",
+	"mKgNyyliChLnwUsX2BDT95DsfeIUXPYBjD1w2hfBadvWeUVywsMBjA3k7Mo1DGFWiK9QC+1/aypeuKvQ",
+	"/7fEasI4bqRCOigYAlykC+IvGWijJE+ZkqEIrjBV72NXnfbKI77Z6v254IVVPvDrnbcRlLtaJ+fw7Yqr",
+	"3Vn5MspnTyY+bk9Yhnu9XBVKoPEazwC6VjHqgoXHCt2RkOVmKP2BxSoT4GzT1GOih8y/bb7Z2x3DL+SO",
+	"bxgFFIf7xap0kpIJWgu51lhp5flwEmh/RaJleMLzUDCauHYwvvWjUc71E3O0+jIiBF9uSC68JUZoAnRt",
+	"JmXJVMXUD8aS51dWLOyLsOcFWi1orbwG+w6OW9LuyafwcfOr8RKthQ93fUUul3TQ3O/mjtyk2x2R7afi",
+	"83BqLT7dvSGnWMuBTO+CTOsa9QqFeqNr4BdAX/s52b+wG9wuROBJ0c+v/c6HRYLoEQwQ8tY6kEnRAKcK",
+	"S8/bWoW8X9HkD4fG93g3RFTdj/uhg+RwR+zyQUlimGA+3DmvnpCXnDh2fTJpN9e91S6P8sB6a1mPUHWI",
+	"zLsXHPYeiPxdKXw6l1ys3R747BP+t5NbAjEc/vNQFLr20R2+DjeR2w9LD2eOt6n3uZFsdXgcaPlz1AgP",
+	"p9LeKwdUHGQWZp3sN/wwcs/t9Eiibw+8fDiXdskQkra0P0fs5EA6UPKBkndNyURTrtDFPqR7cG90W+Co",
+	"EaJ/iop0uxALTHoJVjg6IqJKBD3G6wjDDL/2QfRFLH7hO9ncWBd8cwejQR/tLGDrftjsSmgOdru29D1+",
+	"XdfeKAY2cN1GjK5QHCrHWp1hHT9lE80TMM5I+DNcnqv4CiyLudYUc/uX87c/hkBEEzHg8dSlm3CGhEzp",
+	"LSgYDC2JGZCJYb9lGgzIGH4L9XhrkViuY96UX1cq86sMZMR+S1V8ZSpvVfpkXgLCAwnF9KJcuVzgOLOI",
+	"KvJQyg0Iig92ucjGSZ/fANH8Gz3EeFiKKxlMkcO0hGepwL0i+B0UHohK9ZILQaEsVDyc4EB4Kr9T/R/l",
+	"2hcIaxiO4mHIpR8SgUiUhGP2GldK2TNGje1TFzhjAcsI4SJMbWTRWH4oTH6Jw+L3fsk4v7BRCJNcYFKQ",
+	"0FDNAOJj66xWCzYHDSzlxjLLr0CGADoNEuaYtE3BmDij0obN+IJWtEEx9Gc1EryrkNJnRc/pWsGoDKji",
+	"UyX/qHfI6O50nkdO0DaKUc2FjacI3DutrIpVagZLwK/Xv/JS6UuRJCDvUrl5m/mMsZrYCjvTnjCzonNA",
+	"rGYzhKFT7r0WIR8vPFqt0xUhKyvZZG5sIhoxlSZgfAGEY/az58SMayD6cNkCtZFNiDTlVOyAJ5Xu0ZGP",
+	"idOQpQJqfaVdoZIw7npm80u+Kz57HaK26xgVplb+qY3BKgJ2tCcQcOu6psffdjd12EiKjxQmgHLM3ld2",
+	"mF8izcx91onQfpup7Bz+5PJjKucONaN1cNIAYiKpMD6nWEyiFLEq5h2k3XqJb/hHMctnTOazS9DIkwWa",
+	"ffGQjE+gG8szYWsAJE7NGz19fBqNZm7w0dNHp/iXkP6vAi4hLUxAtwEm4aO9iHNtlA4XkUzDtVC5WQmS",
+	"e+UuSxkHpn3w8SK4DVWKqWmy/rv+PSpuW9bt64rmlnG3F7MAw+E61mZzcdgp4+sjpiQRMmXtl5cTrx10",
+	"0fUKFeXkk/+0sYPXD+D/v3MvWFjFbrWKSqlTNuNXVZV9Av6Kgl/w3E6d+OdByNCVxT1YPRybhbv/86gy",
+	"x9Gr57uF/9CL4u7vKPeoe0XQBjuPv7Yybe+qphN8VihZMae6UmxWzIBUQ6kstS9A+wjl5awronYQJAdB",
+	"cls9CoaoPAc59iCaGrxIhF0rAVsVJbLf9c1h90/fWargwYm5WqGm7anWeSaTOxxR7U8hr4UlXJmelj5X",
+	"UafTznfGHCxH5yAt8yXBjNXAZ8fMVTAs6l4hVSTePk6FSRcZ+AqnWpPlhiGN0SDol0m45aUB8DU39oh+",
+	"O3r13PcuxGl8s9HSpYHWCn+aRMFyUzmKjK80woyQMTCLNiIHoqv4ha0RIXGH/nFYUaxmRQXXIixVFJ4Q",
+	"Z+lHNhOWbI5UY8y1WHaPOChRaYCx0jgtzBAniZLgi7WgpelI55LM5nMtLFCeL1Vupcex6Jir+M5mytm8",
+	"PHgIORVJw1+/PmUJX3jvjGsZbdwyyXJ6mc8yWsc1aCNcoTYsMNtVf6XO/m7CO7OBiiIfhdwqbt80xCCu",
+	"Ee1F4TLGAxF2HdU1ctrSREVlKQmWIzdr/1O7IPg2w0CVf1bxyGcmo84d31ZZkryjG/gfivr0/Q6tF+Hx",
+	"h5HgHpbzZWTBhb3u6E/Q06R5JySwry62fjF3atgsYDgYNlvo97VCb6QvnOOptb2KyOpWG+HXk0/+06ZW",
+	"zED3/v+7Nj4UqzjcCu4sjd3vQac83eBAfeBUtY9T+4s6tPsLOrqZQi0/feV5/so/f+ihf4s99B3SK1bY",
+	"PWoghy4We1RP3EYyo2ZARcJVEZpV5dhqSOlKrj25zNOr7sD2sziGzOLdlaJdudac4r04e3b+N+pY4FgC",
+	"CT9yTWq0mptj9h4LyfLUtbOoxI8FU40ikwpeg3NHSmCYkMYCJzsB1nMPfog8SxVfX17Wi5XvcT0H0XKL",
+	"ogUx7pBfypTIN/ww12t7fayTPad7AfQBtIF78qiHW+UdXyD3fFDqNdcTt5hH3/QRYibPHJe+gUTwD4vM",
+	"vXxvJOAMCydmoLK0JgUZWUtj2Ewa/q6EPKIwlZ52qb8oIV/T8w/DMFWs58tQcnG/l8OSGnTS0z51N6Sw",
+	"LwNVWM2dWqhKIA4mqlVlbslKhVOUBF1LJRwqBE8+/e53YFOjVcEM4cNdGxjKhRzsVrda9ORaXcGSuN2M",
+	"IDc5kG9VAi8p/mfUE8Pp8F+fPim1/c1CkH5UEgYFNO5UFxikB3zdk9DeqIRi8D5f3WFJbaAv+usLd0up",
+	"X+QV1R2Yd67W3FuV5jO0gxU6UMGTbSzZdaicfEoHKDfEufdBqUm3V2gO4fj7Z6v7E1y/kkmi3vrVQ6X9",
+	"B6e+HbS3Vdpbh/K2vrjV4QC492kUGyt5h8PnHmZEFMUaN9Xu1DXoawHz1b35LdUQoZsNT0JFViPkJAVm",
+	"JM/MVNnQs4nFKq8k8nsYGU/n2LiZGIJ+KPq1rTpF3wbwDoaKHZx0iNOA0cOJ13Xi+USKWg9IE1VTtEMJ",
+	"jb6ZJdWBeprnqomaB+LfFfFXsXpggC4GqJLrZgboTKVpbwqnZx+GN5jW8mV4gmmLa1SBX6zosPqWPrie",
+	"BpUiWPgaBTfFZJii2KZMq0wZSMJTAgyW5BPxlBmLgVR5pTyokKBRscilFSl9R0NinaQUB1kbAHX7BLgv",
+	"Qy2u5E4NtQ6Ag++5qxwdd7TZmhUR+KdLnJ58wv+86bWvXMV/7vrO7cC+72L7y5Hag4juhIRpd8DrG+DS",
+	"tpVjZljKdS6kBI0yGX9V9FKZvnwJxjITKw1UutCpljNlLNNcXrFMCVK8i59cucRjdkbjUhXESlVT3Nck",
+	"T31FV3qeSri6Q4WneHn9HWJKWg7vJ74NMSU9hwV8Zdz3/Q4Qx2nPCEsHdvss2W1jd96uXHJINOFsQKJF",
+	"7C7o6ukYZxjHOjbrn19SIWOvqz0MQt69tnWWJIgnj6U70riaQBzc47vqcugZxzWZp7LOW5yb14r6iFfu",
+	"zF6F6+E2qXDk33CYitniASh1HQNXEXUfmf97nqbKHlwln2n4CzeueGxBZV8Zdklb6q5lG3C6BgP6mhen",
+	"bKvf5H3lIV+b31XFcbFkVGOPSudTuZx1fpDqaA/EZlZd0pdxCavSTZXUqt/3D4u8M5LYvWitLOVOzVg1",
+	"OA7WrBZq/l4pbIBWodh2o1aDpNcI0ZNPlb82DTJsyJFimDtWlGorOkQeHiIPN4k8rBDPyoOihzn4y2OP",
+	"B+W13uJI+nIc1n3ZpcfV+3CafG5hjEOVx8NRdu/jGPvxdZtyqXLffrStCP5rmLgruSPrJJRDiHOtXYsO",
+	"dAotmEiw1dJMXbuGWylMWC65MWIiTaNrh3N3EdCh2Gwo+Fnrq2QYgbaujv57gv+uwr4+e4mA2NtIFpzu",
+	"eu57eHn8rOUCZWHGzkdLHLRxHz/LzVW3te4D/tow0yU5kCM4YvRu0SlTSVeTeZ3NjsZ8IMY6WsuXYaWj",
+	"za4RFX7R3y53+9u+ewmKa7hTS5wD4GCCW5nIy81Vu/UtUGyXGDz5hP9tamgjwsZ/7voy5IA/2NQONrVN",
+	"bGpINe1ivYcV7UHT/sNK8xhwcnw5ljI6MzDUTFjT2kG1ouy0XZ3fuMswEkdQj32jfs4kzJmGmZAJaNcW",
+	"xlKPmrl7bIoB/ty0R+bn9nDQfB6X641Vw8Pxdu/tbF1H41r9sdYdv//tyPH2HbSZ3w+P75HT7kNf5xoc",
+	"hytZn97Om3DUHC6nSq2wTb0WxrojIjwakuCKxnd2qkzRS5ryLyglw7VlC7fDVfrtzwGIh2GuCst54Bar",
+	"Jk0sWwLCryvyMyuN9d69Pf/gMjGrvddCvo4B5hQR8/QX+Ys8Yr/959G7lEsJ2rWX++0pQeS6uOGOHzee",
+	"eg6pQJL0Dyb+TyaSqKyjx2OtTKihZ5pDfBAzMJbPst+esp+k+OgCKT0/cGthltnmO+diIrnNNfz2lP1m",
+	"pvzxN3/87jc2Vmmq5mVE5hQ+sj+/OXt2dP7ns8ff/LHgsTBhxDhLlC2yiy5VsoiwsF9Z6K/YDWYg1oCA",
+	"/CLP5II9/vixLBvI4yup5ikk1A6ugodj9tZOQc+FAWzk2CwkCB8dnQieUoNENR5HrhbH16c4oUI1PM/Q",
+	"m/VtQAWV4eBpujaX6U4EwO7PTL+MOz0vCxgOZ2WrJ2cijAVsiRGYJWQPgpNE6wTZijP05JP/tKltM5C/",
+	"//+uL57FKg41mO+id5hH/3b0d+LFuoA+ql35MFpMwFjXlte39XWKnJfpbMYT6KvQFQT9vITmAZD2klnl",
+	"Df8oZvmMyXx2CdRzv8RoYUf5Rw56US0KNxO2ZkNJHAGPnj4+jUYzN+To6aNT/EtI/1cBjZAWJqBvTZ0t",
+	"t/AL0GtTbpELKnxB58KuWfPkk/+8eEVB6f6v7oT3/yeHHIK5M7wblEU6wTzPFhpYeS3rrYW1cO3zAObz",
+	"9wWQD4ORW8Yu92THB+DjHYYdeiDvsbq3u3MRF4pJsb59/IQL2cWCNzf/dwDajh29kN8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,363 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Create a task on a trip.
+// (POST /trips/{tripId}/tasks)
+func (api API) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.TaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	trip := tripFromContext(r.Context())
+
+	assigneeID, ok := api.participantField(w, r, trip.ID, "assignee_id", body.AssigneeID)
+	if !ok {
+		return nil
+	}
+
+	taskID, err := api.store.CreateTask(r.Context(), pgstore.CreateTaskParams{
+		TripID:      trip.ID,
+		Title:       body.Title,
+		Description: text(body.Description),
+		AssigneeID:  assigneeID,
+		DueOn:       dueOn(body.DueOn),
+		Status:      taskStatus(body.Status),
+	})
+	if err != nil {
+		api.logger.Error("failed to create task", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDTasksJSON201Response(spec.CreateTaskResponse{TaskID: taskID.String()})
+}
+
+// Get a trip tasks.
+// (GET /trips/{tripId}/tasks)
+func (api API) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	tasks, err := api.store.GetTripTasks(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get trip tasks", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.GetTripsTripIDTasksJSON200Response(tasksResponse(tasks, time.Now()))
+}
+
+// Get the tasks assigned to a participant.
+// (GET /participants/{participantId}/tasks)
+func (api API) GetParticipantsParticipantIDTasks(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	tasks, err := api.store.GetParticipantTasks(r.Context(), pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		api.logger.Error("failed to get participant tasks", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.GetParticipantsParticipantIDTasksJSON200Response(tasksResponse(tasks, time.Now()))
+}
+
+// Get a trip task and its comments.
+// (GET /trips/{tripId}/tasks/{taskId})
+func (api API) GetTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params spec.GetTripsTripIDTasksTaskIDParams) *spec.Response {
+	task, ok := api.getTask(w, r, tripID, taskID)
+	if !ok {
+		return nil
+	}
+
+	if resp := conditionalGet(w, params.IfNoneMatch, versionETag(task.Version)); resp != nil {
+		return resp
+	}
+
+	comments, err := api.store.GetTaskComments(r.Context(), task.ID)
+	if err != nil {
+		api.logger.Error("failed to get task comments", zap.Error(err), zap.String("task_id", taskID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetTaskResponse{
+		Task:     taskResponse(task, time.Now()),
+		Comments: make([]spec.TaskComment, 0, len(comments)),
+	}
+	for _, comment := range comments {
+		response.Comments = append(response.Comments, spec.TaskComment{
+			ID:        comment.ID.String(),
+			AuthorID:  stringFromUUID(comment.AuthorID),
+			Body:      comment.Body,
+			CreatedAt: comment.CreatedAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDTasksTaskIDJSON200Response(response)
+}
+
+// Update a trip task.
+// (PUT /trips/{tripId}/tasks/{taskId})
+func (api API) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params spec.PutTripsTripIDTasksTaskIDParams) *spec.Response {
+	var body spec.TaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	task, ok := api.getTask(w, r, tripID, taskID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, task.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	assigneeID, ok := api.participantField(w, r, task.TripID, "assignee_id", body.AssigneeID)
+	if !ok {
+		return nil
+	}
+
+	updated, err := api.store.UpdateTask(r.Context(), pgstore.UpdateTaskParams{
+		Title:       body.Title,
+		Description: text(body.Description),
+		AssigneeID:  assigneeID,
+		DueOn:       dueOn(body.DueOn),
+		Status:      taskStatus(body.Status),
+		ID:          task.ID,
+		TripID:      task.TripID,
+		Version:     task.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to update task", zap.Error(err), zap.String("task_id", taskID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if updated == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	w.Header().Set("ETag", versionETag(task.Version+1))
+	return spec.PutTripsTripIDTasksTaskIDJSON204Response(nil)
+}
+
+// Delete a trip task.
+// (DELETE /trips/{tripId}/tasks/{taskId})
+func (api API) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params spec.DeleteTripsTripIDTasksTaskIDParams) *spec.Response {
+	task, ok := api.getTask(w, r, tripID, taskID)
+	if !ok {
+		return nil
+	}
+
+	if preconditionFailed(params.IfMatch, task.Version) {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	deleted, err := api.store.DeleteTask(r.Context(), pgstore.DeleteTaskParams{
+		ID:      task.ID,
+		TripID:  task.TripID,
+		Version: task.Version,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete task", zap.Error(err), zap.String("task_id", taskID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errPreconditionFailed)
+	}
+
+	return spec.DeleteTripsTripIDTasksTaskIDJSON204Response(nil)
+}
+
+// Comment on a trip task.
+// (POST /trips/{tripId}/tasks/{taskId}/comments)
+func (api API) PostTripsTripIDTasksTaskIDComments(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *spec.Response {
+	var body spec.TaskCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	task, ok := api.getTask(w, r, tripID, taskID)
+	if !ok {
+		return nil
+	}
+
+	authorID, ok := api.participantField(w, r, task.TripID, "author_id", &body.AuthorID)
+	if !ok {
+		return nil
+	}
+
+	commentID, err := api.store.CreateTaskComment(r.Context(), pgstore.CreateTaskCommentParams{
+		TaskID:   task.ID,
+		AuthorID: authorID.Bytes,
+		Body:     body.Body,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("task_not_found", "task not found"))
+		}
+
+		api.logger.Error("failed to create task comment", zap.Error(err), zap.String("task_id", taskID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDTasksTaskIDCommentsJSON201Response(spec.CreateTaskCommentResponse{
+		CommentID: commentID.String(),
+	})
+}
+
+// RemindOverdueTasks emails a reminder for every task that went past its due
+// date since the last run, to its assignee or to the trip owner when nobody
+// is assigned, and reports how many went out. Each task is claimed before its
+// email is sent, so a reminder that fails to send is not retried.
+func (api API) RemindOverdueTasks(ctx context.Context) (int, error) {
+	tasks, err := api.store.ClaimOverdueTasks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, task := range tasks {
+		recipient, err := api.taskRecipient(ctx, task)
+		if err != nil {
+			api.logger.Error("failed to find task reminder recipient", zap.Error(err), zap.String("task_id", task.ID.String()))
+			continue
+		}
+
+		reminder := mailpit.TaskReminder{Title: task.Title, DueOn: task.DueOn.Time}
+		if err := api.mailer.SendTaskReminderEmail(recipient, reminder, task.TripID); err != nil {
+			api.logger.Error("failed to send task reminder email", zap.Error(err), zap.String("task_id", task.ID.String()))
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// taskRecipient is who gets reminded about an overdue task.
+func (api API) taskRecipient(ctx context.Context, task pgstore.ClaimOverdueTasksRow) (mailpit.ParticipantToSendEmail, error) {
+	if task.AssigneeID.Valid {
+		participant, err := api.store.GetParticipant(ctx, task.AssigneeID.Bytes)
+		if err != nil {
+			return mailpit.ParticipantToSendEmail{}, err
+		}
+		return mailpit.ParticipantToSendEmail{Name: participantName(participant), Email: participant.Email}, nil
+	}
+
+	trip, err := api.store.GetTrip(ctx, task.TripID)
+	if err != nil {
+		return mailpit.ParticipantToSendEmail{}, err
+	}
+	return mailpit.ParticipantToSendEmail{Name: trip.OwnerName, Email: trip.OwnerEmail}, nil
+}
+
+// getTask loads a task of a trip. When it reports false the problem has
+// already been written.
+func (api API) getTask(w http.ResponseWriter, r *http.Request, tripID, taskID string) (pgstore.Task, bool) {
+	tid, err := uuid.Parse(tripID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Task{}, false
+	}
+
+	id, err := uuid.Parse(taskID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Task{}, false
+	}
+
+	task, err := api.store.GetTask(r.Context(), pgstore.GetTaskParams{ID: id, TripID: tid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errNotFound("task_not_found", "task not found"))
+			return pgstore.Task{}, false
+		}
+
+		api.logger.Error("failed to get task", zap.Error(err), zap.String("task_id", taskID))
+		api.problem(w, r, errInternal)
+		return pgstore.Task{}, false
+	}
+
+	return task, true
+}
+
+func dueOn(d *types.Date) pgtype.Date {
+	if d == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: d.Time, Valid: true}
+}
+
+func taskStatus(s *spec.TaskStatus) string {
+	if s == nil {
+		return spec.TaskStatusTodo.ToValue()
+	}
+	return s.ToValue()
+}
+
+func tasksResponse(tasks []pgstore.Task, now time.Time) spec.GetTasksResponse {
+	response := spec.GetTasksResponse{Tasks: make([]spec.Task, 0, len(tasks))}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, taskResponse(task, now))
+	}
+	return response
+}
+
+func taskResponse(task pgstore.Task, now time.Time) spec.Task {
+	var status spec.TaskStatus
+	_ = status.FromValue(task.Status)
+
+	response := spec.Task{
+		ID:          task.ID.String(),
+		TripID:      task.TripID.String(),
+		Title:       task.Title,
+		Description: stringFromText(task.Description),
+		AssigneeID:  stringFromUUID(task.AssigneeID),
+		Status:      status,
+		Overdue:     task.DueOn.Valid && task.Status != spec.TaskStatusDone.ToValue() && task.DueOn.Time.Before(dateOf(now)),
+	}
+	if task.DueOn.Valid {
+		response.DueOn = &types.Date{Time: task.DueOn.Time}
+	}
+	return response
+}
@@ -0,0 +1,290 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+type tripContextKey struct{}
+
+func tripFromContext(ctx context.Context) pgstore.Trip {
+	trip, ok := ctx.Value(tripContextKey{}).(pgstore.Trip)
+	if !ok {
+		panic("api: trip not loaded, wrap the server with API.LoadTrips")
+	}
+	return trip
+}
+
+// tripLoader resolves {tripId} once for the handlers that need the trip row
+// and stores it in the request context. Handlers that only need to know the
+// trip exists fold that check into their own query instead.
+type tripLoader struct {
+	spec.ServerInterface
+	api API
+}
+
+// LoadTrips wraps si so trip-scoped handlers can read the trip from the
+// request context. Unknown trips are answered with a 404 before the handler
+// runs.
+func (api API) LoadTrips(si spec.ServerInterface) spec.ServerInterface {
+	return tripLoader{si, api}
+}
+
+func (tl tripLoader) withTrip(w http.ResponseWriter, r *http.Request, tripID string, next func(http.ResponseWriter, *http.Request) *spec.Response) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return tl.api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	trip, err := tl.api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return tl.api.problem(w, r, errNotFound("trip_not_found", "trip not found"))
+		}
+
+		tl.api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return tl.api.problem(w, r, errInternal)
+	}
+
+	ctx := context.WithValue(r.Context(), tripContextKey{}, trip)
+	return next(w, r.WithContext(ctx))
+}
+
+// Get a trip details.
+// (GET /trips/{tripId})
+func (tl tripLoader) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripID(w, r, tripID, params)
+	})
+}
+
+// Update a trip.
+// (PUT /trips/{tripId})
+func (tl tripLoader) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PutTripsTripID(w, r, tripID, params)
+	})
+}
+
+// Partially update a trip.
+// (PATCH /trips/{tripId})
+func (tl tripLoader) PatchTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PatchTripsTripID(w, r, tripID, params)
+	})
+}
+
+// Get who owes what on a trip and how to settle up.
+// (GET /trips/{tripId}/balances)
+func (tl tripLoader) GetTripsTripIDBalances(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDBalances(w, r, tripID)
+	})
+}
+
+// Get a trip budget with planned and actual spend per category.
+// (GET /trips/{tripId}/budget)
+func (tl tripLoader) GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDBudget(w, r, tripID)
+	})
+}
+
+// Set the budget of a category.
+// (PUT /trips/{tripId}/budgets/{category})
+func (tl tripLoader) PutTripsTripIDBudgetsCategory(w http.ResponseWriter, r *http.Request, tripID string, category spec.PutTripsTripIDBudgetsCategoryParamsCategory) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PutTripsTripIDBudgetsCategory(w, r, tripID, category)
+	})
+}
+
+// Get a trip reservations.
+// (GET /trips/{tripId}/reservations)
+func (tl tripLoader) GetTripsTripIDReservations(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDReservations(w, r, tripID)
+	})
+}
+
+// Export a trip itinerary as an iCalendar file.
+// (GET /trips/{tripId}/calendar.ics)
+func (tl tripLoader) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDCalendarIcs(w, r, tripID)
+	})
+}
+
+// Replace the route of a trip.
+// (PUT /trips/{tripId}/route)
+func (tl tripLoader) PutTripsTripIDRoute(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDRouteParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PutTripsTripIDRoute(w, r, tripID, params)
+	})
+}
+
+// Create a checklist on a trip.
+// (POST /trips/{tripId}/checklists)
+func (tl tripLoader) PostTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDChecklists(w, r, tripID)
+	})
+}
+
+// Get a trip checklists.
+// (GET /trips/{tripId}/checklists)
+func (tl tripLoader) GetTripsTripIDChecklists(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDChecklistsParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDChecklists(w, r, tripID, params)
+	})
+}
+
+// Save a checklist as a template.
+// (POST /trips/{tripId}/checklists/{checklistId}/template)
+func (tl tripLoader) PostTripsTripIDChecklistsChecklistIDTemplate(w http.ResponseWriter, r *http.Request, tripID string, checklistID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDChecklistsChecklistIDTemplate(w, r, tripID, checklistID)
+	})
+}
+
+// Get the checklist templates of a trip owner.
+// (GET /trips/{tripId}/checklists/templates)
+func (tl tripLoader) GetTripsTripIDChecklistsTemplates(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDChecklistsTemplates(w, r, tripID)
+	})
+}
+
+// Delete a checklist template of a trip owner.
+// (DELETE /trips/{tripId}/checklists/templates/{templateId})
+func (tl tripLoader) DeleteTripsTripIDChecklistsTemplatesTemplateID(w http.ResponseWriter, r *http.Request, tripID string, templateID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.DeleteTripsTripIDChecklistsTemplatesTemplateID(w, r, tripID, templateID)
+	})
+}
+
+// Create a task on a trip.
+// (POST /trips/{tripId}/tasks)
+func (tl tripLoader) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDTasks(w, r, tripID)
+	})
+}
+
+// Get a trip tasks.
+// (GET /trips/{tripId}/tasks)
+func (tl tripLoader) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDTasks(w, r, tripID)
+	})
+}
+
+// Comment on a trip, one of its activities or links.
+// (POST /trips/{tripId}/comments)
+func (tl tripLoader) PostTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDComments(w, r, tripID)
+	})
+}
+
+// Get a page of comments.
+// (GET /trips/{tripId}/comments)
+func (tl tripLoader) GetTripsTripIDComments(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDCommentsParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDComments(w, r, tripID, params)
+	})
+}
+
+// Stream the changes to a trip.
+// (GET /trips/{tripId}/events)
+func (tl tripLoader) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDEventsParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDEvents(w, r, tripID, params)
+	})
+}
+
+// Open the collaboration channel of a trip.
+// (GET /trips/{tripId}/collaboration)
+func (tl tripLoader) GetTripsTripIDCollaboration(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDCollaborationParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDCollaboration(w, r, tripID, params)
+	})
+}
+
+// Register a webhook for the events of a trip.
+// (POST /trips/{tripId}/webhooks)
+func (tl tripLoader) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDWebhooks(w, r, tripID)
+	})
+}
+
+// Get the webhooks of a trip.
+// (GET /trips/{tripId}/webhooks)
+func (tl tripLoader) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDWebhooks(w, r, tripID)
+	})
+}
+
+// Delete a webhook.
+// (DELETE /trips/{tripId}/webhooks/{webhookId})
+func (tl tripLoader) DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.DeleteTripsTripIDWebhooksWebhookID(w, r, tripID, webhookID)
+	})
+}
+
+// Get the latest deliveries of a webhook.
+// (GET /trips/{tripId}/webhooks/{webhookId}/deliveries)
+func (tl tripLoader) GetTripsTripIDWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, params spec.GetTripsTripIDWebhooksWebhookIDDeliveriesParams) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDWebhooksWebhookIDDeliveries(w, r, tripID, webhookID, params)
+	})
+}
+
+// Deliver an event again.
+// (POST /trips/{tripId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+func (tl tripLoader) PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, deliveryID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w, r, tripID, webhookID, deliveryID)
+	})
+}
+
+// Add a chat channel for the events of a trip.
+// (POST /trips/{tripId}/chat-channels)
+func (tl tripLoader) PostTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDChatChannels(w, r, tripID)
+	})
+}
+
+// Get the chat channels of a trip.
+// (GET /trips/{tripId}/chat-channels)
+func (tl tripLoader) GetTripsTripIDChatChannels(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.GetTripsTripIDChatChannels(w, r, tripID)
+	})
+}
+
+// Delete a chat channel.
+// (DELETE /trips/{tripId}/chat-channels/{channelId})
+func (tl tripLoader) DeleteTripsTripIDChatChannelsChannelID(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.DeleteTripsTripIDChatChannelsChannelID(w, r, tripID, channelID)
+	})
+}
+
+// Post a test message to a chat channel.
+// (POST /trips/{tripId}/chat-channels/{channelId}/test)
+func (tl tripLoader) PostTripsTripIDChatChannelsChannelIDTest(w http.ResponseWriter, r *http.Request, tripID string, channelID string) *spec.Response {
+	return tl.withTrip(w, r, tripID, func(w http.ResponseWriter, r *http.Request) *spec.Response {
+		return tl.ServerInterface.PostTripsTripIDChatChannelsChannelIDTest(w, r, tripID, channelID)
+	})
+}
@@ -0,0 +1,303 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/currency"
+)
+
+// maxTripDays caps how long a single trip may last.
+const maxTripDays = 180
+
+// newValidator returns a validator that reports fields by their JSON names and
+// knows the rules specific to the planner on top of the built-in ones.
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	// The rules are only registered once at startup with valid names, so an
+	// error here is a programming mistake.
+	must(v.RegisterValidation("future", validateFuture))
+	must(v.RegisterValidation("unique_emails", validateUniqueEmails))
+	must(v.RegisterValidation("urlscheme", validateURLScheme))
+	must(v.RegisterValidation("amount", validateAmount))
+	must(v.RegisterValidation("currency", validateCurrency))
+	must(v.RegisterValidation("rate", validateRate))
+
+	v.RegisterStructValidation(validateCreateTrip, spec.CreateTripRequest{})
+	v.RegisterStructValidation(validateUpdateTrip, spec.UpdateTripRequest{})
+	v.RegisterStructValidation(validateCreatePoll, spec.CreatePollRequest{})
+	v.RegisterStructValidation(validateBallot, spec.BallotRequest{})
+	v.RegisterStructValidation(validateCreateExpense, spec.CreateExpenseRequest{})
+	v.RegisterStructValidation(validateMoney, spec.Money{})
+	v.RegisterStructValidation(validateReservation, spec.ReservationRequest{})
+	v.RegisterStructValidation(validateRoute, spec.RouteRequest{})
+
+	return v
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// validateFuture checks that a time.Time lies after now.
+func validateFuture(fl validator.FieldLevel) bool {
+	t, ok := fl.Field().Interface().(time.Time)
+	if !ok {
+		return false
+	}
+	return t.After(time.Now())
+}
+
+// validateUniqueEmails checks that a list of emails has no duplicates,
+// ignoring case.
+func validateUniqueEmails(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.Slice {
+		return false
+	}
+
+	seen := make(map[string]struct{}, field.Len())
+	for i := range field.Len() {
+		email := strings.ToLower(field.Index(i).String())
+		if _, ok := seen[email]; ok {
+			return false
+		}
+		seen[email] = struct{}{}
+	}
+	return true
+}
+
+// validateURLScheme checks that a URL uses one of the space separated schemes
+// given as the rule parameter, e.g. urlscheme=http https.
+func validateURLScheme(fl validator.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	return slices.Contains(strings.Fields(fl.Param()), strings.ToLower(u.Scheme))
+}
+
+func validateCreateTrip(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.CreateTripRequest)
+	validateTripLength(sl, body.StartsAt, body.EndsAt)
+
+	for _, email := range body.EmailsToInvite {
+		if strings.EqualFold(string(email), string(body.OwnerEmail)) {
+			sl.ReportError(body.EmailsToInvite, "emails_to_invite", "EmailsToInvite", "no_self_invite", "")
+			return
+		}
+	}
+}
+
+func validateUpdateTrip(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.UpdateTripRequest)
+	validateTripLength(sl, body.StartsAt, body.EndsAt)
+}
+
+// validateTripLength reports ends_at when the trip lasts longer than
+// maxTripDays. Ordering is left to the gtfield rule on ends_at.
+func validateTripLength(sl validator.StructLevel, startsAt, endsAt time.Time) {
+	if endsAt.Sub(startsAt) > maxTripDays*24*time.Hour {
+		sl.ReportError(endsAt, "ends_at", "EndsAt", "max_trip_length", strconv.Itoa(maxTripDays))
+	}
+}
+
+// validateCreatePoll checks that every option carries the fields its poll
+// kind needs: a title and occurs_at for activities, a date range for dates.
+func validateCreatePoll(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.CreatePollRequest)
+
+	kind := body.Kind.ToValue()
+	if kind == "" {
+		sl.ReportError(body.Kind, "kind", "Kind", "required", "")
+		return
+	}
+
+	for i, option := range body.Options {
+		field := func(name string) string { return fmt.Sprintf("options[%d].%s", i, name) }
+
+		if kind == spec.PollKindDates.ToValue() {
+			if option.StartsAt == nil {
+				sl.ReportError(option.StartsAt, field("starts_at"), "StartsAt", "required", "")
+			}
+			if option.EndsAt == nil {
+				sl.ReportError(option.EndsAt, field("ends_at"), "EndsAt", "required", "")
+			}
+			if option.StartsAt != nil && option.EndsAt != nil && option.EndsAt.Sub(*option.StartsAt) > maxTripDays*24*time.Hour {
+				sl.ReportError(option.EndsAt, field("ends_at"), "EndsAt", "max_trip_length", strconv.Itoa(maxTripDays))
+			}
+			continue
+		}
+
+		if option.Title == nil || *option.Title == "" {
+			sl.ReportError(option.Title, field("title"), "Title", "required", "")
+		}
+		if option.OccursAt == nil {
+			sl.ReportError(option.OccursAt, field("occurs_at"), "OccursAt", "required", "")
+		}
+	}
+}
+
+// validateBallot checks that every entry votes, ranks or both, and that no two
+// entries share a rank.
+func validateBallot(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.BallotRequest)
+
+	ranks := make(map[int]struct{}, len(body.Votes))
+	for i, entry := range body.Votes {
+		if entry.Vote == nil && entry.Rank == nil {
+			sl.ReportError(entry, fmt.Sprintf("votes[%d]", i), "Votes", "vote_or_rank", "")
+		}
+
+		if entry.Rank == nil {
+			continue
+		}
+		if _, ok := ranks[*entry.Rank]; ok {
+			sl.ReportError(body.Votes, "votes", "Votes", "unique_ranks", "")
+			return
+		}
+		ranks[*entry.Rank] = struct{}{}
+	}
+}
+
+// validateCreateExpense checks that amounts fit the currency's minor unit,
+// that the splits carry what the split mode needs, and that exact amounts add
+// up to the expense.
+func validateCreateExpense(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.CreateExpenseRequest)
+
+	mode := body.SplitMode.ToValue()
+	if mode == "" {
+		sl.ReportError(body.SplitMode, "split_mode", "SplitMode", "required", "")
+		return
+	}
+
+	// Without a known currency there is no telling how many decimals the
+	// amounts may have, the currency rule already reports it.
+	if !currency.Valid(body.Currency) {
+		return
+	}
+	precision := strconv.Itoa(currency.Exponent(body.Currency))
+
+	total, err := currency.Parse(body.Amount, body.Currency)
+	if errors.Is(err, currency.ErrPrecision) {
+		sl.ReportError(body.Amount, "amount", "Amount", "currency_precision", precision)
+	}
+
+	var sum int64
+	complete := err == nil && total > 0
+	for i, split := range body.Splits {
+		switch mode {
+		case spec.SplitModeShares.ToValue():
+			if split.Shares == nil {
+				sl.ReportError(split.Shares, fmt.Sprintf("splits[%d].shares", i), "Shares", "required", "")
+			}
+		case spec.SplitModeExact.ToValue():
+			if split.Amount == nil {
+				sl.ReportError(split.Amount, fmt.Sprintf("splits[%d].amount", i), "Amount", "required", "")
+				complete = false
+				continue
+			}
+			amount, err := currency.Parse(*split.Amount, body.Currency)
+			if errors.Is(err, currency.ErrPrecision) {
+				sl.ReportError(*split.Amount, fmt.Sprintf("splits[%d].amount", i), "Amount", "currency_precision", precision)
+			}
+			if err != nil {
+				complete = false
+			}
+			sum += amount
+		}
+	}
+
+	if mode != spec.SplitModeExact.ToValue() || !complete {
+		return
+	}
+	if sum != total {
+		sl.ReportError(body.Splits, "splits", "Splits", "split_total", body.Amount)
+	}
+}
+
+// validateMoney checks that an amount has no more decimals than its currency.
+func validateMoney(sl validator.StructLevel) {
+	money := sl.Current().Interface().(spec.Money)
+	if !currency.Valid(money.Currency) {
+		return
+	}
+
+	if _, err := currency.Parse(money.Amount, money.Currency); errors.Is(err, currency.ErrPrecision) {
+		sl.ReportError(money.Amount, "amount", "Amount", "currency_precision", strconv.Itoa(currency.Exponent(money.Currency)))
+	}
+}
+
+// validateReservation checks the fields that depend on the kind: flights and
+// trains go somewhere and may have a number, lodgings stay put.
+func validateReservation(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.ReservationRequest)
+
+	kind := body.Kind.ToValue()
+	switch kind {
+	case "":
+		sl.ReportError(body.Kind, "kind", "Kind", "required", "")
+
+	case spec.ReservationKindFlight.ToValue(), spec.ReservationKindTrain.ToValue():
+		if body.EndLocation == nil || *body.EndLocation == "" {
+			sl.ReportError(body.EndLocation, "end_location", "EndLocation", "required", "")
+		}
+
+	case spec.ReservationKindLodging.ToValue():
+		if body.EndLocation != nil {
+			sl.ReportError(body.EndLocation, "end_location", "EndLocation", "not_for_kind", kind)
+		}
+		fallthrough
+
+	default:
+		if body.Number != nil {
+			sl.ReportError(body.Number, "number", "Number", "not_for_kind", kind)
+		}
+	}
+}
+
+// validateRoute checks that every leg ends on or after the day it starts, that
+// each leg picks up on the day the previous one ends, and that no leg is kept
+// twice.
+func validateRoute(sl validator.StructLevel) {
+	body := sl.Current().Interface().(spec.RouteRequest)
+
+	ids := make(map[string]struct{}, len(body.Legs))
+	for i, leg := range body.Legs {
+		if leg.EndsOn.Before(leg.StartsOn.Time) {
+			sl.ReportError(leg.EndsOn, fmt.Sprintf("legs[%d].ends_on", i), "EndsOn", "gtefield", "StartsOn")
+		}
+
+		if i > 0 && !leg.StartsOn.Equal(body.Legs[i-1].EndsOn.Time) {
+			sl.ReportError(leg.StartsOn, fmt.Sprintf("legs[%d].starts_on", i), "StartsOn", "contiguous", "")
+		}
+
+		if leg.ID == nil {
+			continue
+		}
+		if _, ok := ids[*leg.ID]; ok {
+			sl.ReportError(body.Legs, "legs", "Legs", "unique", "ID")
+			return
+		}
+		ids[*leg.ID] = struct{}{}
+	}
+}
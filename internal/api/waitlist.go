@@ -0,0 +1,120 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/mailer/mailpit"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Decline a trip invitation.
+// (POST /participants/{participantId}/decline)
+func (api API) PostParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errNotFound("participant_not_found", "participant not found"))
+		}
+
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	promoted, err := api.store.DeclineInvite(r.Context(), api.pool, participant)
+	if err != nil {
+		api.logger.Error("failed to decline participant", zap.Error(err), zap.String("participant_id", participantID))
+		return api.problem(w, r, errInternal)
+	}
+
+	api.notifyPromoted(promoted)
+
+	return spec.PostParticipantsParticipantIDDeclineJSON204Response(nil)
+}
+
+// promoteWaitlist fills seats freed by a change to the trip's capacity.
+// Failing to promote doesn't fail the change itself, the next seat released
+// retries the promotion.
+func (api API) promoteWaitlist(r *http.Request, tripID uuid.UUID) {
+	promoted, err := api.store.PromoteWaitlist(r.Context(), api.pool, tripID)
+	if err != nil {
+		api.logger.Error("failed to promote waitlist", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	api.notifyPromoted(promoted)
+}
+
+// notifyPromoted lets participants know they got a seat on the trip.
+func (api API) notifyPromoted(promoted []pgstore.Participant) {
+	for _, participant := range promoted {
+		go func() {
+			if err := api.mailer.SendPromotedFromWaitlistEmailToParticipant(mailpit.ParticipantToSendEmail{
+				Name:  participantName(participant),
+				Email: participant.Email,
+			}, participant.TripID); err != nil {
+				api.logger.Error(
+					"failed to send promoted from waitlist email",
+					zap.Error(err),
+					zap.String("participant_id", participant.ID.String()),
+				)
+			}
+		}()
+	}
+}
+
+// capacityRaised reports whether changing a trip's capacity from old to new
+// frees seats.
+func capacityRaised(old, new pgtype.Int4) bool {
+	return old.Valid && (!new.Valid || new.Int32 > old.Int32)
+}
+
+func int4FromInt(v *int) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*v), Valid: true}
+}
+
+func intFromInt4(v pgtype.Int4) *int {
+	if !v.Valid {
+		return nil
+	}
+	return ptr(int(v.Int32))
+}
+
+// waitlistPositions numbers waitlisted participants in the order they joined
+// the waitlist.
+func waitlistPositions(participants []pgstore.GetParticipantsRow) map[[16]byte]int {
+	var waitlisted []pgstore.GetParticipantsRow
+	for _, participant := range participants {
+		if participant.ID.Valid && participant.WaitlistedAt.Valid {
+			waitlisted = append(waitlisted, participant)
+		}
+	}
+
+	slices.SortFunc(waitlisted, func(a, b pgstore.GetParticipantsRow) int {
+		if c := a.WaitlistedAt.Time.Compare(b.WaitlistedAt.Time); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.ID.Bytes[:], b.ID.Bytes[:])
+	})
+
+	positions := make(map[[16]byte]int, len(waitlisted))
+	for i, participant := range waitlisted {
+		positions[participant.ID.Bytes] = i + 1
+	}
+	return positions
+}
@@ -0,0 +1,451 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/netguard"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookBatch = 20
+	// webhookLease is how long a claimed delivery is left alone before it is
+	// claimed again, e.g. when the instance attempting it went away. It must
+	// outlast webhookTimeout.
+	webhookLease       = time.Minute
+	webhookTimeout     = 10 * time.Second
+	webhookFirstRetry  = 30 * time.Second
+	webhookMaxAttempts = 8
+
+	defaultDeliveryPage = 20
+	maxDeliveryPage     = 100
+)
+
+var (
+	errWebhookNotFound  = errNotFound("webhook_not_found", "webhook not found")
+	errDeliveryNotFound = errNotFound("delivery_not_found", "delivery not found")
+)
+
+// newWebhookClient returns the client deliveries are POSTed with. It only
+// connects to public addresses and doesn't follow redirects, so the receiver
+// has to answer at the registered URL.
+func newWebhookClient() *http.Client {
+	return netguard.NewClient(webhookTimeout)
+}
+
+// Register a webhook for the events of a trip.
+// (POST /trips/{tripId}/webhooks)
+func (api API) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.WebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return api.problem(w, r, errBadRequest("invalid_json", "invalid json"))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return api.problem(w, r, errValidation(err))
+	}
+
+	if err := checkPublicURL(r.Context(), "url", body.URL); err != nil {
+		return api.problem(w, r, err)
+	}
+
+	trip := tripFromContext(r.Context())
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		api.logger.Error("failed to generate webhook secret", zap.Error(err))
+		return api.problem(w, r, errInternal)
+	}
+
+	params := pgstore.CreateWebhookParams{
+		OwnerEmail: trip.OwnerEmail,
+		TripID:     pgtype.UUID{Bytes: trip.ID, Valid: true},
+		Url:        body.URL,
+		Events:     make([]string, 0, len(body.Events)),
+		Secret:     secret,
+	}
+	if body.AllTrips != nil && *body.AllTrips {
+		params.TripID = pgtype.UUID{}
+	}
+	for _, event := range body.Events {
+		params.Events = append(params.Events, event.ToValue())
+	}
+
+	webhookID, err := api.store.CreateWebhook(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to create webhook", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDWebhooksJSON201Response(spec.CreateWebhookResponse{
+		WebhookID: webhookID.String(),
+		Secret:    secret,
+	})
+}
+
+// Get the webhooks of a trip.
+// (GET /trips/{tripId}/webhooks)
+func (api API) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	webhooks, err := api.store.GetTripWebhooks(r.Context(), pgstore.GetTripWebhooksParams{
+		TripID:     trip.ID,
+		OwnerEmail: trip.OwnerEmail,
+	})
+	if err != nil {
+		api.logger.Error("failed to get webhooks", zap.Error(err), zap.String("trip_id", tripID))
+		return api.problem(w, r, errInternal)
+	}
+
+	response := spec.GetWebhooksResponse{Webhooks: make([]spec.Webhook, 0, len(webhooks))}
+	for _, webhook := range webhooks {
+		events := make([]spec.TripEventType, 0, len(webhook.Events))
+		for _, event := range webhook.Events {
+			events = append(events, tripEventType(event))
+		}
+
+		response.Webhooks = append(response.Webhooks, spec.Webhook{
+			ID:        webhook.ID.String(),
+			URL:       webhook.Url,
+			Events:    events,
+			AllTrips:  !webhook.TripID.Valid,
+			CreatedAt: webhook.CreatedAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDWebhooksJSON200Response(response)
+}
+
+// Delete a webhook.
+// (DELETE /trips/{tripId}/webhooks/{webhookId})
+func (api API) DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *spec.Response {
+	trip := tripFromContext(r.Context())
+
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	deleted, err := api.store.DeleteTripWebhook(r.Context(), pgstore.DeleteTripWebhookParams{
+		ID:         id,
+		TripID:     trip.ID,
+		OwnerEmail: trip.OwnerEmail,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		return api.problem(w, r, errInternal)
+	}
+
+	if deleted == 0 {
+		return api.problem(w, r, errWebhookNotFound)
+	}
+
+	return spec.DeleteTripsTripIDWebhooksWebhookIDJSON204Response(nil)
+}
+
+// Get the latest deliveries of a webhook.
+// (GET /trips/{tripId}/webhooks/{webhookId}/deliveries)
+func (api API) GetTripsTripIDWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, params spec.GetTripsTripIDWebhooksWebhookIDDeliveriesParams) *spec.Response {
+	webhook, ok := api.getWebhook(w, r, webhookID)
+	if !ok {
+		return nil
+	}
+
+	limit := defaultDeliveryPage
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxDeliveryPage {
+			return api.problem(w, r, errBadRequest("invalid_limit", "limit must be between 1 and "+strconv.Itoa(maxDeliveryPage)))
+		}
+		limit = *params.Limit
+	}
+
+	deliveries, err := api.store.GetWebhookDeliveries(r.Context(), pgstore.GetWebhookDeliveriesParams{
+		WebhookID:  webhook.ID,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		api.logger.Error("failed to get webhook deliveries", zap.Error(err), zap.String("webhook_id", webhookID))
+		return api.problem(w, r, errInternal)
+	}
+
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+
+	attempts, err := api.store.GetWebhookAttempts(r.Context(), ids)
+	if err != nil {
+		api.logger.Error("failed to get webhook attempts", zap.Error(err), zap.String("webhook_id", webhookID))
+		return api.problem(w, r, errInternal)
+	}
+
+	byDelivery := make(map[uuid.UUID][]spec.WebhookAttempt, len(deliveries))
+	for _, attempt := range attempts {
+		a := spec.WebhookAttempt{
+			AttemptedAt: attempt.AttemptedAt.Time,
+			Error:       stringFromText(attempt.Error),
+			DurationMs:  int(attempt.DurationMs),
+		}
+		if attempt.StatusCode.Valid {
+			a.StatusCode = ptr(int(attempt.StatusCode.Int32))
+		}
+		byDelivery[attempt.DeliveryID] = append(byDelivery[attempt.DeliveryID], a)
+	}
+
+	response := spec.GetWebhookDeliveriesResponse{Deliveries: make([]spec.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		var status spec.WebhookDeliveryStatus
+		_ = status.FromValue(delivery.Status)
+
+		d := spec.WebhookDelivery{
+			ID: delivery.ID.String(),
+			Event: tripEventResponse(pgstore.TripEvent{
+				ID:        delivery.EventID,
+				TripID:    delivery.TripID,
+				Type:      delivery.Type,
+				SubjectID: delivery.SubjectID,
+				Fields:    delivery.Fields,
+				CreatedAt: delivery.OccurredAt,
+			}),
+			Status:    status,
+			Attempts:  byDelivery[delivery.ID],
+			CreatedAt: delivery.CreatedAt.Time,
+		}
+		if d.Attempts == nil {
+			d.Attempts = []spec.WebhookAttempt{}
+		}
+		if delivery.Status == "pending" {
+			d.NextAttemptAt = ptr(delivery.NextAttemptAt.Time)
+		}
+		response.Deliveries = append(response.Deliveries, d)
+	}
+
+	return spec.GetTripsTripIDWebhooksWebhookIDDeliveriesJSON200Response(response)
+}
+
+// Deliver an event again.
+// (POST /trips/{tripId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+func (api API) PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(w http.ResponseWriter, r *http.Request, tripID string, webhookID string, deliveryID string) *spec.Response {
+	webhook, ok := api.getWebhook(w, r, webhookID)
+	if !ok {
+		return nil
+	}
+
+	id, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+	}
+
+	delivery, err := api.store.GetWebhookDelivery(r.Context(), pgstore.GetWebhookDeliveryParams{ID: id, WebhookID: webhook.ID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.problem(w, r, errDeliveryNotFound)
+		}
+
+		api.logger.Error("failed to get webhook delivery", zap.Error(err), zap.String("delivery_id", deliveryID))
+		return api.problem(w, r, errInternal)
+	}
+
+	redeliveryID, err := api.store.CreateWebhookDelivery(r.Context(), pgstore.CreateWebhookDeliveryParams{
+		WebhookID: webhook.ID,
+		EventID:   delivery.EventID,
+	})
+	if err != nil {
+		api.logger.Error("failed to create webhook delivery", zap.Error(err), zap.String("delivery_id", deliveryID))
+		return api.problem(w, r, errInternal)
+	}
+
+	return spec.PostTripsTripIDWebhooksWebhookIDDeliveriesDeliveryIDRedeliverJSON202Response(spec.RedeliverWebhookResponse{
+		DeliveryID: redeliveryID.String(),
+	})
+}
+
+// getWebhook loads a webhook of the trip in the request context, or one of
+// its owner for every trip. When it reports false the problem has already
+// been written.
+func (api API) getWebhook(w http.ResponseWriter, r *http.Request, webhookID string) (pgstore.Webhook, bool) {
+	trip := tripFromContext(r.Context())
+
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		api.problem(w, r, errBadRequest("invalid_uuid", "invalid UUID"))
+		return pgstore.Webhook{}, false
+	}
+
+	webhook, err := api.store.GetTripWebhook(r.Context(), pgstore.GetTripWebhookParams{
+		ID:         id,
+		TripID:     trip.ID,
+		OwnerEmail: trip.OwnerEmail,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			api.problem(w, r, errWebhookNotFound)
+			return pgstore.Webhook{}, false
+		}
+
+		api.logger.Error("failed to get webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		api.problem(w, r, errInternal)
+		return pgstore.Webhook{}, false
+	}
+
+	return webhook, true
+}
+
+// DeliverWebhooks attempts the webhook deliveries that are due and reports how
+// many were attempted. Deliveries are claimed first, so instances running it
+// side by side don't attempt the same one.
+func (api API) DeliverWebhooks(ctx context.Context) (int, error) {
+	deliveries, err := api.store.ClaimWebhookDeliveries(ctx, pgstore.ClaimWebhookDeliveriesParams{
+		MaxResults:   webhookBatch,
+		LeaseSeconds: int32(webhookLease / time.Second),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery pgstore.ClaimWebhookDeliveriesRow) {
+			defer wg.Done()
+			api.deliverWebhook(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliverWebhook makes one attempt at a delivery and records it, scheduling
+// the next attempt when it failed and attempts are left.
+func (api API) deliverWebhook(ctx context.Context, delivery pgstore.ClaimWebhookDeliveriesRow) {
+	body, err := json.Marshal(tripEventResponse(pgstore.TripEvent{
+		ID:        delivery.EventID,
+		TripID:    delivery.TripID,
+		Type:      delivery.Type,
+		SubjectID: delivery.SubjectID,
+		Fields:    delivery.Fields,
+		CreatedAt: delivery.OccurredAt,
+	}))
+	if err != nil {
+		api.logger.Error("failed to encode webhook payload", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+		return
+	}
+
+	attempt := pgstore.RecordWebhookAttemptParams{DeliveryID: delivery.ID}
+
+	start := time.Now()
+	statusCode, err := api.postWebhook(ctx, delivery, body)
+	attempt.DurationMs = int32(time.Since(start) / time.Millisecond)
+
+	// An attempt cut short by shutting down isn't the receiver's fault, the
+	// lease running out lets it be made again.
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		attempt.Error = pgtype.Text{String: err.Error(), Valid: true}
+	} else {
+		attempt.StatusCode = pgtype.Int4{Int32: int32(statusCode), Valid: true}
+	}
+
+	switch {
+	case err == nil && statusCode >= 200 && statusCode < 300:
+		attempt.Status = "succeeded"
+	case delivery.Attempts+1 >= webhookMaxAttempts:
+		attempt.Status = "failed"
+	default:
+		attempt.Status = "pending"
+		attempt.RetryInSeconds = int32(webhookRetryDelay(int(delivery.Attempts)+1) / time.Second)
+	}
+
+	if err := api.store.RecordWebhookAttempt(ctx, attempt); err != nil {
+		api.logger.Error("failed to record webhook attempt", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+	}
+}
+
+// postWebhook POSTs a signed payload to the webhook and returns the status
+// code of the response.
+func (api API) postWebhook(ctx context.Context, delivery pgstore.ClaimWebhookDeliveriesRow, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-plann.er-webhooks")
+	req.Header.Set("X-Planner-Event", delivery.Type)
+	req.Header.Set("X-Planner-Delivery", delivery.ID.String())
+	req.Header.Set("X-Planner-Timestamp", timestamp)
+	req.Header.Set("X-Planner-Signature", signWebhook(delivery.Secret, timestamp, body))
+
+	resp, err := api.webhooks.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Draining the body lets the connection be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
+
+// signWebhook returns the X-Planner-Signature of a payload sent at timestamp:
+// the hex HMAC-SHA256 of the timestamp, a dot and the payload.
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay is how long to wait after the nth failed attempt, doubling
+// every time.
+func webhookRetryDelay(n int) time.Duration {
+	return webhookFirstRetry << (n - 1)
+}
+
+// checkPublicURL makes sure a URL the server is going to send requests to
+// resolves to public addresses only.
+func checkPublicURL(ctx context.Context, field, rawURL string) error {
+	err := netguard.CheckURL(ctx, rawURL)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, netguard.ErrBlockedAddress):
+		return errInvalidField(field, "public_url", "must resolve to a public address")
+	default:
+		return errInvalidField(field, "public_url", "must have a host that resolves")
+	}
+}
+
+// newWebhookSecret returns a random key for signing the deliveries of a
+// webhook.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
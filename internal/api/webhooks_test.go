@@ -0,0 +1,596 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go-plann.er/internal/api/spec"
+	"go-plann.er/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// webhookStore keeps the webhooks and deliveries of one trip in memory.
+type webhookStore struct {
+	store
+
+	mu         sync.Mutex
+	trip       pgstore.Trip
+	webhook    pgstore.Webhook
+	events     map[int64]pgstore.TripEvent
+	deliveries map[uuid.UUID]*pgstore.WebhookDelivery
+	attempts   []pgstore.RecordWebhookAttemptParams
+}
+
+func newWebhookStore(url string, events ...pgstore.TripEvent) *webhookStore {
+	trip := pgstore.Trip{ID: uuid.New(), Destination: "Lisbon", OwnerEmail: "owner@example.com"}
+	s := &webhookStore{
+		trip: trip,
+		webhook: pgstore.Webhook{
+			ID:         uuid.New(),
+			OwnerEmail: trip.OwnerEmail,
+			TripID:     pgtype.UUID{Bytes: trip.ID, Valid: true},
+			Url:        url,
+			Secret:     "s3cret",
+		},
+		events:     make(map[int64]pgstore.TripEvent),
+		deliveries: make(map[uuid.UUID]*pgstore.WebhookDelivery),
+	}
+	for _, event := range events {
+		event.TripID = trip.ID
+		s.events[event.ID] = event
+	}
+	return s
+}
+
+// queue adds a pending delivery of an event that has had attempts already.
+func (s *webhookStore) queue(eventID int64, attempts int32) uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := uuid.New()
+	s.deliveries[id] = &pgstore.WebhookDelivery{
+		ID:            id,
+		WebhookID:     s.webhook.ID,
+		EventID:       eventID,
+		Status:        "pending",
+		Attempts:      attempts,
+		NextAttemptAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+	return id
+}
+
+func (s *webhookStore) delivery(id uuid.UUID) pgstore.WebhookDelivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.deliveries[id]
+}
+
+func (s *webhookStore) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	if id != s.trip.ID {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return s.trip, nil
+}
+
+func (s *webhookStore) GetTripWebhook(_ context.Context, arg pgstore.GetTripWebhookParams) (pgstore.Webhook, error) {
+	if arg.ID != s.webhook.ID {
+		return pgstore.Webhook{}, pgx.ErrNoRows
+	}
+	if s.webhook.TripID.Valid && arg.TripID != s.webhook.TripID.Bytes ||
+		!s.webhook.TripID.Valid && !strings.EqualFold(arg.OwnerEmail, s.webhook.OwnerEmail) {
+		return pgstore.Webhook{}, pgx.ErrNoRows
+	}
+	return s.webhook, nil
+}
+
+func (s *webhookStore) GetWebhookDelivery(_ context.Context, arg pgstore.GetWebhookDeliveryParams) (pgstore.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivery, ok := s.deliveries[arg.ID]
+	if !ok || delivery.WebhookID != arg.WebhookID {
+		return pgstore.WebhookDelivery{}, pgx.ErrNoRows
+	}
+	return *delivery, nil
+}
+
+func (s *webhookStore) CreateWebhookDelivery(_ context.Context, arg pgstore.CreateWebhookDeliveryParams) (uuid.UUID, error) {
+	return s.queue(arg.EventID, 0), nil
+}
+
+func (s *webhookStore) ClaimWebhookDeliveries(_ context.Context, arg pgstore.ClaimWebhookDeliveriesParams) ([]pgstore.ClaimWebhookDeliveriesRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rows []pgstore.ClaimWebhookDeliveriesRow
+	for _, delivery := range s.deliveries {
+		if delivery.Status != "pending" || delivery.NextAttemptAt.Time.After(time.Now()) {
+			continue
+		}
+		delivery.NextAttemptAt.Time = time.Now().Add(time.Duration(arg.LeaseSeconds) * time.Second)
+
+		event := s.events[delivery.EventID]
+		rows = append(rows, pgstore.ClaimWebhookDeliveriesRow{
+			ID:         delivery.ID,
+			WebhookID:  delivery.WebhookID,
+			Attempts:   delivery.Attempts,
+			Url:        s.webhook.Url,
+			Secret:     s.webhook.Secret,
+			EventID:    event.ID,
+			TripID:     event.TripID,
+			Type:       event.Type,
+			SubjectID:  event.SubjectID,
+			Fields:     event.Fields,
+			OccurredAt: event.CreatedAt,
+		})
+	}
+	return rows, nil
+}
+
+func (s *webhookStore) RecordWebhookAttempt(_ context.Context, arg pgstore.RecordWebhookAttemptParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts = append(s.attempts, arg)
+	delivery := s.deliveries[arg.DeliveryID]
+	delivery.Status = arg.Status
+	delivery.Attempts++
+	delivery.NextAttemptAt.Time = time.Now().Add(time.Duration(arg.RetryInSeconds) * time.Second)
+	return nil
+}
+
+// newWebhookAPI returns an API on top of s. Its client may reach the local
+// receivers tests start, which the client deliveries go out with never does.
+func newWebhookAPI(s store, timeout time.Duration) API {
+	return API{store: s, logger: zap.NewNop(), validator: newValidator(), webhooks: &http.Client{Timeout: timeout}}
+}
+
+// receiver is a local webhook endpoint answering with the status codes it is
+// given in turn, and 204 once they run out.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	status := http.StatusNoContent
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	rc.mu.Unlock()
+
+	w.WriteHeader(status)
+}
+
+func activityCreated(id int64) pgstore.TripEvent {
+	return pgstore.TripEvent{
+		ID:        id,
+		Type:      "activity.created",
+		SubjectID: uuid.New(),
+		CreatedAt: pgtype.Timestamp{Time: time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC), Valid: true},
+	}
+}
+
+func deliver(t *testing.T, api API, want int) {
+	t.Helper()
+
+	n, err := api.DeliverWebhooks(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverWebhooks: %v", err)
+	}
+	if n != want {
+		t.Fatalf("DeliverWebhooks attempted %d deliveries, want %d", n, want)
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := signWebhook("s3cret", "1700000000", body); got != want {
+		t.Errorf("signWebhook = %s, want %s", got, want)
+	}
+	if got := signWebhook("other", "1700000000", body); got == want {
+		t.Error("signWebhook doesn't depend on the secret")
+	}
+	if got := signWebhook("s3cret", "1700000001", body); got == want {
+		t.Error("signWebhook doesn't depend on the timestamp")
+	}
+}
+
+func TestDeliverWebhookSucceeds(t *testing.T) {
+	rc := &receiver{statuses: []int{http.StatusOK}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	s := newWebhookStore(srv.URL, activityCreated(7))
+	id := s.queue(7, 0)
+	deliver(t, newWebhookAPI(s, time.Second), 1)
+
+	if got := s.delivery(id); got.Status != "succeeded" || got.Attempts != 1 {
+		t.Errorf("delivery is %s after %d attempts, want succeeded after 1", got.Status, got.Attempts)
+	}
+	if s.attempts[0].StatusCode.Int32 != http.StatusOK {
+		t.Errorf("recorded status code %d, want 200", s.attempts[0].StatusCode.Int32)
+	}
+
+	r, body := rc.requests[0], rc.bodies[0]
+	if got := r.Header.Get("X-Planner-Event"); got != "activity.created" {
+		t.Errorf("X-Planner-Event = %q, want activity.created", got)
+	}
+	if got := r.Header.Get("X-Planner-Delivery"); got != id.String() {
+		t.Errorf("X-Planner-Delivery = %q, want %s", got, id)
+	}
+
+	timestamp := r.Header.Get("X-Planner-Timestamp")
+	if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+		t.Errorf("X-Planner-Timestamp = %q, want Unix time", timestamp)
+	}
+	if got, want := r.Header.Get("X-Planner-Signature"), signWebhook("s3cret", timestamp, body); got != want {
+		t.Errorf("X-Planner-Signature = %q, want %q", got, want)
+	}
+
+	var event spec.TripEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatalf("payload isn't a TripEvent: %v", err)
+	}
+	if event.ID != "7" || event.Type != spec.TripEventTypeActivityCreated || event.TripID != s.trip.ID.String() {
+		t.Errorf("payload = %+v, want event 7 of the trip", event)
+	}
+}
+
+func TestDeliverWebhookRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		attempts  int32
+		wantRetry time.Duration
+	}{
+		{"first attempt", 0, 30 * time.Second},
+		{"third attempt", 2, 2 * time.Minute},
+		{"seventh attempt", 6, 32 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(&receiver{statuses: []int{http.StatusBadGateway}})
+			defer srv.Close()
+
+			s := newWebhookStore(srv.URL, activityCreated(1))
+			id := s.queue(1, tt.attempts)
+			deliver(t, newWebhookAPI(s, time.Second), 1)
+
+			got := s.delivery(id)
+			if got.Status != "pending" || got.Attempts != tt.attempts+1 {
+				t.Errorf("delivery is %s after %d attempts, want pending after %d", got.Status, got.Attempts, tt.attempts+1)
+			}
+			if retry := time.Duration(s.attempts[0].RetryInSeconds) * time.Second; retry != tt.wantRetry {
+				t.Errorf("retry in %s, want %s", retry, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestDeliverWebhookTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	s := newWebhookStore(srv.URL, activityCreated(1))
+	id := s.queue(1, 0)
+	deliver(t, newWebhookAPI(s, 50*time.Millisecond), 1)
+
+	if got := s.delivery(id); got.Status != "pending" {
+		t.Errorf("delivery is %s, want pending", got.Status)
+	}
+
+	attempt := s.attempts[0]
+	if attempt.StatusCode.Valid || !attempt.Error.Valid {
+		t.Errorf("attempt recorded status %v and error %v, want only an error", attempt.StatusCode, attempt.Error)
+	}
+	if attempt.RetryInSeconds != 30 {
+		t.Errorf("retry in %ds, want 30s", attempt.RetryInSeconds)
+	}
+}
+
+func TestDeliverWebhookFailsAfterLastAttempt(t *testing.T) {
+	srv := httptest.NewServer(&receiver{statuses: []int{http.StatusInternalServerError}})
+	defer srv.Close()
+
+	s := newWebhookStore(srv.URL, activityCreated(1))
+	id := s.queue(1, webhookMaxAttempts-1)
+	api := newWebhookAPI(s, time.Second)
+	deliver(t, api, 1)
+
+	if got := s.delivery(id); got.Status != "failed" || got.Attempts != webhookMaxAttempts {
+		t.Errorf("delivery is %s after %d attempts, want failed after %d", got.Status, got.Attempts, webhookMaxAttempts)
+	}
+
+	// Failed deliveries aren't attempted anymore.
+	deliver(t, api, 0)
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	rc := &receiver{}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	s := newWebhookStore(srv.URL, activityCreated(3))
+	failed := s.queue(3, webhookMaxAttempts-1)
+	s.deliveries[failed].Status = "failed"
+
+	api := newWebhookAPI(s, time.Second)
+	handler := spec.Handler(api.LoadTrips(&api), spec.WithErrorHandler(api.ErrorHandler))
+
+	redeliver := func(tripID, webhookID, deliveryID string) *httptest.ResponseRecorder {
+		path := fmt.Sprintf("/trips/%s/webhooks/%s/deliveries/%s/redeliver", tripID, webhookID, deliveryID)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		return w
+	}
+
+	trip, webhook := s.trip.ID.String(), s.webhook.ID.String()
+	for _, tt := range []struct {
+		name                        string
+		tripID, webhookID, delivery string
+		want                        int
+	}{
+		{"unknown delivery", trip, webhook, uuid.NewString(), http.StatusNotFound},
+		{"webhook of another trip", trip, uuid.NewString(), failed.String(), http.StatusNotFound},
+		{"invalid delivery id", trip, webhook, "nope", http.StatusBadRequest},
+	} {
+		if w := redeliver(tt.tripID, tt.webhookID, tt.delivery); w.Code != tt.want {
+			t.Errorf("%s: status %d, want %d: %s", tt.name, w.Code, tt.want, w.Body)
+		}
+	}
+
+	w := redeliver(trip, webhook, failed.String())
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("status %d, want 202: %s", w.Code, w.Body)
+	}
+
+	var body spec.RedeliverWebhookResponse
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	redelivery, err := uuid.Parse(body.DeliveryID)
+	if err != nil || redelivery == failed {
+		t.Fatalf("deliveryId = %q, want a new delivery", body.DeliveryID)
+	}
+
+	deliver(t, api, 1)
+
+	if got := s.delivery(redelivery); got.Status != "succeeded" || got.EventID != 3 {
+		t.Errorf("redelivery of event %d is %s, want event 3 succeeded", got.EventID, got.Status)
+	}
+	if got := s.delivery(failed); got.Status != "failed" {
+		t.Errorf("original delivery is %s, want it left failed", got.Status)
+	}
+	if got := rc.requests[0].Header.Get("X-Planner-Delivery"); got != redelivery.String() {
+		t.Errorf("X-Planner-Delivery = %q, want %s", got, redelivery)
+	}
+}
+
+func TestPostWebhookRejectsPrivateURLs(t *testing.T) {
+	s := newWebhookStore("")
+	api := newWebhookAPI(s, time.Second)
+	handler := spec.Handler(api.LoadTrips(&api), spec.WithErrorHandler(api.ErrorHandler))
+
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://10.1.2.3/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+		"http://[fd00:ec2::254]/hook",
+	} {
+		body := strings.NewReader(`{"url": "` + url + `"}`)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/trips/"+s.trip.ID.String()+"/webhooks", body))
+
+		if w.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: status %d, want 422: %s", url, w.Code, w.Body)
+		}
+	}
+}
+
+// TestWebhookEventFilter checks which events migration 025 queues deliveries
+// for, to the webhooks of a trip and to those of its owner. It needs a Postgres database, named by PLANNER_TEST_DATABASE_URL, and
+// runs the migrations in a schema of its own.
+func TestWebhookEventFilter(t *testing.T) {
+	dsn := os.Getenv("PLANNER_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("PLANNER_TEST_DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	pool := migratedPool(t, ctx, dsn)
+
+	createTrip := func(ownerEmail string) uuid.UUID {
+		t.Helper()
+
+		var id uuid.UUID
+		if err := pool.QueryRow(ctx, `
+			INSERT INTO trips (destination, owner_email, owner_name, starts_at, ends_at)
+			VALUES ('Lisbon', $1, 'Owner', NOW(), NOW() + INTERVAL '3 days')
+			RETURNING id`,
+			ownerEmail,
+		).Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	tripID := createTrip("owner@example.com")
+	sameOwner := createTrip("Owner@Example.com")
+	otherOwner := createTrip("someone@example.com")
+
+	rc := &receiver{}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	q := pgstore.New(pool)
+	createWebhook := func(tripID pgtype.UUID, events ...string) uuid.UUID {
+		t.Helper()
+
+		id, err := q.CreateWebhook(ctx, pgstore.CreateWebhookParams{
+			OwnerEmail: "owner@example.com",
+			TripID:     tripID,
+			Url:        srv.URL,
+			Events:     append([]string{}, events...),
+			Secret:     "s3cret",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	trip := pgtype.UUID{Bytes: tripID, Valid: true}
+	all := createWebhook(trip)
+	activities := createWebhook(trip, "activity.created")
+	owner := createWebhook(pgtype.UUID{}, "trip.updated")
+
+	if _, err := pool.Exec(ctx, `INSERT INTO activities (trip_id, title, occurs_at) VALUES ($1, 'Dinner', NOW() + INTERVAL '1 day')`, tripID); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []uuid.UUID{tripID, sameOwner, otherOwner} {
+		if _, err := pool.Exec(ctx, `UPDATE trips SET destination = 'Porto' WHERE id = $1`, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for webhook, want := range map[uuid.UUID][]string{
+		all:        {"activity.created", "trip.updated"},
+		activities: {"activity.created"},
+		// The trip.updated of the other owner's trip isn't delivered.
+		owner: {"trip.updated", "trip.updated"},
+	} {
+		deliveries, err := q.GetWebhookDeliveries(ctx, pgstore.GetWebhookDeliveriesParams{WebhookID: webhook, MaxResults: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, d := range deliveries {
+			got = append(got, d.Type)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("webhook %s got deliveries of %v, want %v", webhook, got, want)
+		}
+	}
+
+	// The owner's webhooks show up on each of their trips only.
+	for _, tt := range []struct {
+		tripID     uuid.UUID
+		ownerEmail string
+		want       int
+	}{
+		{tripID, "owner@example.com", 3},
+		{sameOwner, "Owner@Example.com", 1},
+		{otherOwner, "someone@example.com", 0},
+	} {
+		webhooks, err := q.GetTripWebhooks(ctx, pgstore.GetTripWebhooksParams{TripID: tt.tripID, OwnerEmail: tt.ownerEmail})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(webhooks) != tt.want {
+			t.Errorf("trip of %s lists %d webhooks, want %d", tt.ownerEmail, len(webhooks), tt.want)
+		}
+	}
+
+	api := API{store: q, logger: zap.NewNop(), webhooks: &http.Client{Timeout: time.Second}}
+	deliver(t, api, 5)
+
+	if len(rc.requests) != 5 {
+		t.Errorf("receiver got %d requests, want 5", len(rc.requests))
+	}
+}
+
+// migratedPool returns a pool on a new schema of the database at dsn, with
+// every migration applied. The schema is dropped when the test ends.
+func migratedPool(t *testing.T, ctx context.Context, dsn string) *pgxpool.Pool {
+	t.Helper()
+
+	schema := "planner_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	admin, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer admin.Close(ctx)
+
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(context.Background(), dsn)
+		if err != nil {
+			return
+		}
+		defer conn.Close(context.Background())
+		_, _ = conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrations, err := filepath.Glob("../pgstore/migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(migrations)
+
+	for _, migration := range migrations {
+		sql, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		up, _, _ := strings.Cut(string(sql), "---- create above / drop below ----")
+		if _, err := pool.Exec(ctx, up); err != nil {
+			t.Fatalf("%s: %v", filepath.Base(migration), err)
+		}
+	}
+
+	return pool
+}
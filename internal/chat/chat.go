@@ -0,0 +1,115 @@
+// Package chat posts trip notifications to chat platforms through their
+// incoming webhooks. Every platform formats messages and reports failures its
+// own way, the errors returned here tell callers what to do about a failure.
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"go-plann.er/internal/netguard"
+)
+
+const (
+	timeout = 10 * time.Second
+	// defaultRetryAfter is how long to wait when a platform limits the rate
+	// without saying for how long.
+	defaultRetryAfter = 30 * time.Second
+	maxResponseSize   = 64 << 10
+)
+
+// Notification is something that happened on a trip, as posted to a chat.
+type Notification struct {
+	// Trip names the trip, e.g. "Trip to Lisbon".
+	Trip string
+	// Title says what happened, e.g. "New activity".
+	Title string
+	// Text gives the details, it may be empty.
+	Text       string
+	OccurredAt time.Time
+}
+
+var (
+	// ErrGone means the incoming webhook was removed or may no longer post,
+	// no message will get through until it is configured again.
+	ErrGone = errors.New("chat: incoming webhook is gone")
+	// ErrRejected means the platform refused the message itself, others may
+	// still get through.
+	ErrRejected = errors.New("chat: message rejected")
+)
+
+// RateLimitError means the platform asks not to post again before RetryAfter.
+type RateLimitError struct {
+	Platform   string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("chat: rate limited by %s, retry after %s", e.Platform, e.RetryAfter)
+}
+
+// newClient returns the client messages are POSTed with. Like for webhooks,
+// it only connects to public addresses and doesn't follow redirects.
+func newClient() *http.Client {
+	return netguard.NewClient(timeout)
+}
+
+// post POSTs payload as JSON to url and returns the response, with its body
+// read.
+func post(ctx context.Context, client *http.Client, url string, payload any) (*http.Response, []byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("chat: failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("chat: invalid incoming webhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-plann.er-chat")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("chat: failed to post message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("chat: failed to read response: %w", err)
+	}
+
+	return resp, data, nil
+}
+
+// retryAfter reads the Retry-After header in seconds, falling back to
+// defaultRetryAfter.
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return defaultRetryAfter
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// truncate cuts s to at most n runes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+// excerpt returns the start of a response body for error messages.
+func excerpt(body []byte) string {
+	return truncate(string(bytes.TrimSpace(body)), 200)
+}
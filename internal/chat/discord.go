@@ -0,0 +1,113 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The most an embed of a Discord message may hold.
+const (
+	maxDiscordTitle       = 256
+	maxDiscordDescription = 4096
+	maxDiscordFooter      = 2048
+
+	discordColor = 0x4F46E5
+)
+
+// Discord posts to Discord webhooks, and to the ones of platforms compatible
+// with them.
+type Discord struct {
+	client *http.Client
+}
+
+func NewDiscord() Discord {
+	return Discord{client: newClient()}
+}
+
+type discordMessage struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+	// AllowedMentions is left empty, so names such as @everyone in trip
+	// details never ping anyone.
+	AllowedMentions discordMentions `json:"allowed_mentions"`
+}
+
+type discordEmbed struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description,omitempty"`
+	Color       int           `json:"color"`
+	Timestamp   string        `json:"timestamp"`
+	Footer      discordFooter `json:"footer"`
+}
+
+type discordFooter struct {
+	Text string `json:"text"`
+}
+
+type discordMentions struct {
+	Parse []string `json:"parse"`
+}
+
+// discordRateLimit is the body of a 429 from Discord.
+type discordRateLimit struct {
+	RetryAfter float64 `json:"retry_after"`
+}
+
+func (d Discord) Notify(ctx context.Context, webhookURL string, notification Notification) error {
+	resp, body, err := post(ctx, d.client, webhookURL, discordPayload(notification))
+	if err != nil {
+		return err
+	}
+
+	// Discord answers with a JSON error, such as {"code": 10015, "message":
+	// "Unknown Webhook"}.
+	switch {
+	case resp.StatusCode == http.StatusNoContent, resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return &RateLimitError{Platform: "discord", RetryAfter: discordRetryAfter(resp.Header, body)}
+	case resp.StatusCode == http.StatusUnauthorized,
+		resp.StatusCode == http.StatusForbidden,
+		resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: discord answered %d %s", ErrGone, resp.StatusCode, excerpt(body))
+	case resp.StatusCode == http.StatusBadRequest,
+		resp.StatusCode == http.StatusRequestEntityTooLarge:
+		return fmt.Errorf("%w: discord answered %d %s", ErrRejected, resp.StatusCode, excerpt(body))
+	default:
+		return fmt.Errorf("chat: discord answered %d %s", resp.StatusCode, excerpt(body))
+	}
+}
+
+func discordPayload(n Notification) discordMessage {
+	return discordMessage{
+		Username: "plann.er",
+		Embeds: []discordEmbed{{
+			Title:       truncate(discordEscape(n.Title), maxDiscordTitle),
+			Description: truncate(discordEscape(n.Text), maxDiscordDescription),
+			Color:       discordColor,
+			Timestamp:   n.OccurredAt.UTC().Format(time.RFC3339),
+			Footer:      discordFooter{Text: truncate(n.Trip, maxDiscordFooter)},
+		}},
+		AllowedMentions: discordMentions{Parse: []string{}},
+	}
+}
+
+// discordRetryAfter reads the seconds to wait from the body of a 429, which
+// may be fractional, falling back to the Retry-After header.
+func discordRetryAfter(header http.Header, body []byte) time.Duration {
+	var limit discordRateLimit
+	if err := json.Unmarshal(body, &limit); err == nil && limit.RetryAfter > 0 {
+		return time.Duration(math.Ceil(limit.RetryAfter)) * time.Second
+	}
+	return retryAfter(header)
+}
+
+// discordEscape escapes the characters Discord reads as markdown.
+var discordEscape = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, ">", `\>`,
+).Replace
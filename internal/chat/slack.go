@@ -0,0 +1,86 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxSlackText is the most a section block of a Slack message may hold.
+const maxSlackText = 3000
+
+// Slack posts to Slack incoming webhooks, and to the ones of platforms
+// compatible with them such as Mattermost.
+type Slack struct {
+	client *http.Client
+}
+
+func NewSlack() Slack {
+	return Slack{client: newClient()}
+}
+
+type slackMessage struct {
+	// Text is shown in notifications, and instead of the blocks by clients
+	// without them.
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (s Slack) Notify(ctx context.Context, webhookURL string, notification Notification) error {
+	resp, body, err := post(ctx, s.client, webhookURL, slackPayload(notification))
+	if err != nil {
+		return err
+	}
+
+	// Slack answers with a plain text error code, such as no_service or
+	// invalid_payload.
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return &RateLimitError{Platform: "slack", RetryAfter: retryAfter(resp.Header)}
+	case resp.StatusCode == http.StatusForbidden,
+		resp.StatusCode == http.StatusNotFound,
+		resp.StatusCode == http.StatusGone:
+		return fmt.Errorf("%w: slack answered %d %s", ErrGone, resp.StatusCode, excerpt(body))
+	case resp.StatusCode == http.StatusBadRequest:
+		return fmt.Errorf("%w: slack answered %d %s", ErrRejected, resp.StatusCode, excerpt(body))
+	default:
+		return fmt.Errorf("chat: slack answered %d %s", resp.StatusCode, excerpt(body))
+	}
+}
+
+func slackPayload(n Notification) slackMessage {
+	text := "*" + slackEscape(n.Title) + "*"
+	if n.Text != "" {
+		text += "\n" + slackEscape(n.Text)
+	}
+
+	return slackMessage{
+		Text: slackEscape(n.Trip + ": " + n.Title),
+		Blocks: []slackBlock{
+			{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncate(text, maxSlackText)}},
+			{Type: "context", Elements: []slackText{{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("%s · <!date^%d^{date_short_pretty} {time}|%s>",
+					slackEscape(n.Trip), n.OccurredAt.Unix(), n.OccurredAt.UTC().Format("2006-01-02 15:04 UTC")),
+			}}},
+		},
+	}
+}
+
+// slackEscape escapes the characters Slack reads as the start of links and
+// mentions.
+var slackEscape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
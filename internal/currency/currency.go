@@ -0,0 +1,120 @@
+// Package currency knows the ISO 4217 currencies and does exact arithmetic on
+// amounts held as integers of a currency's minor unit, so money never passes
+// through a float.
+package currency
+
+import (
+	"errors"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// exponents maps the active ISO 4217 codes to the number of digits of their
+// minor unit. Funds and precious metals without a minor unit are left out.
+var exponents = map[string]int{
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "AOA": 2, "ARS": 2, "AUD": 2, "AWG": 2,
+	"AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2, "BHD": 3, "BIF": 0, "BMD": 2,
+	"BND": 2, "BOB": 2, "BOV": 2, "BRL": 2, "BSD": 2, "BTN": 2, "BWP": 2, "BYN": 2,
+	"BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2, "CHF": 2, "CHW": 2, "CLF": 4, "CLP": 0,
+	"CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2, "DJF": 0,
+	"DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2, "EUR": 2, "FJD": 2,
+	"FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2, "GMD": 2, "GNF": 0, "GTQ": 2,
+	"GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2,
+	"IQD": 3, "IRR": 2, "ISK": 0, "JMD": 2, "JOD": 3, "JPY": 0, "KES": 2, "KGS": 2,
+	"KHR": 2, "KMF": 0, "KPW": 2, "KRW": 0, "KWD": 3, "KYD": 2, "KZT": 2, "LAK": 2,
+	"LBP": 2, "LKR": 2, "LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2,
+	"MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2,
+	"MXN": 2, "MXV": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2,
+	"NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2, "PGK": 2, "PHP": 2, "PKR": 2,
+	"PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "RWF": 0, "SAR": 2,
+	"SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2, "SLE": 2, "SOS": 2,
+	"SRD": 2, "SSP": 2, "STN": 2, "SVC": 2, "SYP": 2, "SZL": 2, "THB": 2, "TJS": 2,
+	"TMT": 2, "TND": 3, "TOP": 2, "TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2,
+	"UGX": 0, "USD": 2, "USN": 2, "UYI": 0, "UYU": 2, "UYW": 4, "UZS": 2, "VED": 2,
+	"VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0, "XCD": 2, "XCG": 2, "XOF": 0,
+	"XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+}
+
+// Valid reports whether code is an active ISO 4217 currency code.
+func Valid(code string) bool {
+	_, ok := exponents[code]
+	return ok
+}
+
+// Exponent returns the number of digits of the minor unit of code, 2 for the
+// cents of EUR and 0 for JPY. It panics on an unknown code.
+func Exponent(code string) int {
+	exp, ok := exponents[code]
+	if !ok {
+		panic("currency: unknown currency " + strconv.Quote(code))
+	}
+	return exp
+}
+
+var decimalPattern = regexp.MustCompile(`^[0-9]{1,13}(\.[0-9]{1,4})?$`)
+
+var (
+	ErrInvalidAmount = errors.New("currency: invalid amount")
+	ErrPrecision     = errors.New("currency: too many decimals for the currency")
+	ErrOverflow      = errors.New("currency: amount out of range")
+)
+
+// Parse reads a decimal amount such as "12.5" into minor units of code,
+// rejecting more decimals than the currency has.
+func Parse(s, code string) (int64, error) {
+	if !decimalPattern.MatchString(s) {
+		return 0, ErrInvalidAmount
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	exp := Exponent(code)
+	if len(frac) > exp {
+		return 0, ErrPrecision
+	}
+
+	frac += strings.Repeat("0", exp-len(frac))
+	return strconv.ParseInt(whole+frac, 10, 64)
+}
+
+// Format writes minor units of code as a decimal amount such as "12.50".
+func Format(minor int64, code string) string {
+	sign := ""
+	digits := strconv.FormatInt(minor, 10)
+	if minor < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	exp := Exponent(code)
+	if exp == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// Convert turns minor units of from into minor units of to, where rate is the
+// price of one from in to. The result is rounded half away from zero.
+func Convert(minor int64, from, to string, rate *big.Rat) (int64, error) {
+	v := new(big.Rat).SetInt64(minor)
+	v.Mul(v, rate)
+	v.Mul(v, new(big.Rat).SetFrac(pow10(Exponent(to)), pow10(Exponent(from))))
+
+	q, m := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if m.Sign() != 0 && new(big.Int).Mul(m.Abs(m), big.NewInt(2)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(v.Sign())))
+	}
+
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return q.Int64(), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,98 @@
+package currency
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Provider is a source of exchange rates, such as a file shipped with the
+// deployment or a rates service.
+type Provider interface {
+	// Name identifies the provider as the source of the rates it returns.
+	Name() string
+	Rates(ctx context.Context) ([]Rate, error)
+}
+
+// FileProvider reads rates from a CSV file in the format of ReadCSV. It works
+// offline, which makes it the default provider.
+type FileProvider struct {
+	Path string
+}
+
+func NewFileProvider(path string) FileProvider {
+	return FileProvider{Path: path}
+}
+
+func (p FileProvider) Name() string {
+	return "file"
+}
+
+func (p FileProvider) Rates(_ context.Context) ([]Rate, error) {
+	f, err := os.Open(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("currency: failed to open rates file: %w", err)
+	}
+	defer f.Close()
+
+	return ReadCSV(f)
+}
+
+// ReadCSV reads rates from effective_on,base,quote,rate rows such as
+// 2024-07-01,EUR,USD,1.0742. A first row naming the columns is skipped.
+func ReadCSV(r io.Reader) ([]Rate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	var rates []Rate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && strings.EqualFold(record[0], "effective_on") {
+			continue
+		}
+
+		rate, err := parseRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rates = append(rates, rate)
+	}
+}
+
+func parseRecord(record []string) (Rate, error) {
+	effectiveOn, err := time.Parse(time.DateOnly, record[0])
+	if err != nil {
+		return Rate{}, errors.New("invalid effective_on date")
+	}
+
+	base, quote := record[1], record[2]
+	if !Valid(base) {
+		return Rate{}, fmt.Errorf("unknown currency %q", base)
+	}
+	if !Valid(quote) {
+		return Rate{}, fmt.Errorf("unknown currency %q", quote)
+	}
+	if base == quote {
+		return Rate{}, errors.New("base and quote are the same currency")
+	}
+
+	rate, err := ParseRate(record[3])
+	if err != nil {
+		return Rate{}, fmt.Errorf("invalid rate %q", record[3])
+	}
+
+	return Rate{Base: base, Quote: quote, Rate: rate, EffectiveOn: effectiveOn}, nil
+}
@@ -0,0 +1,111 @@
+package currency
+
+import (
+	"errors"
+	"math/big"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// Rate is the price of one Base in Quote from EffectiveOn until a later rate
+// for the same pair takes over.
+type Rate struct {
+	Base        string
+	Quote       string
+	Rate        *big.Rat
+	EffectiveOn time.Time
+}
+
+var ratePattern = regexp.MustCompile(`^[0-9]{1,12}(\.[0-9]{1,12})?$`)
+
+var ErrInvalidRate = errors.New("currency: invalid exchange rate")
+
+// ParseRate reads a positive decimal rate such as "1.0842" exactly.
+func ParseRate(s string) (*big.Rat, error) {
+	if !ratePattern.MatchString(s) {
+		return nil, ErrInvalidRate
+	}
+
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return rate, nil
+}
+
+// FormatRate writes a rate as a decimal, exactly when it has a finite
+// expansion and to twelve decimals otherwise.
+func FormatRate(rate *big.Rat) string {
+	prec, exact := rate.FloatPrec()
+	if !exact {
+		prec = 12
+	}
+	return rate.FloatString(prec)
+}
+
+type pair struct{ base, quote string }
+
+// Table answers conversions between any two currencies from a set of rates,
+// using the inverse of a rate or going through a third currency when there is
+// no direct rate.
+type Table struct {
+	rates map[pair]*big.Rat
+	// currencies lists every currency with a rate, sorted, so that crossing
+	// through a third currency picks the same one every time.
+	currencies []string
+}
+
+// NewTable builds a table from rates. A later rate for a pair replaces an
+// earlier one.
+func NewTable(rates []Rate) Table {
+	t := Table{rates: make(map[pair]*big.Rat, len(rates))}
+	for _, rate := range rates {
+		t.rates[pair{rate.Base, rate.Quote}] = rate.Rate
+		for _, code := range []string{rate.Base, rate.Quote} {
+			if i, found := slices.BinarySearch(t.currencies, code); !found {
+				t.currencies = slices.Insert(t.currencies, i, code)
+			}
+		}
+	}
+	return t
+}
+
+// Lookup returns the price of one from in to, reporting false when the table
+// cannot tell.
+func (t Table) Lookup(from, to string) (*big.Rat, bool) {
+	if rate, ok := t.direct(from, to); ok {
+		return rate, true
+	}
+
+	for _, via := range t.currencies {
+		if via == from || via == to {
+			continue
+		}
+
+		first, ok := t.direct(from, via)
+		if !ok {
+			continue
+		}
+		second, ok := t.direct(via, to)
+		if !ok {
+			continue
+		}
+		return new(big.Rat).Mul(first, second), true
+	}
+
+	return nil, false
+}
+
+func (t Table) direct(from, to string) (*big.Rat, bool) {
+	if from == to {
+		return big.NewRat(1, 1), true
+	}
+	if rate, ok := t.rates[pair{from, to}]; ok {
+		return rate, true
+	}
+	if rate, ok := t.rates[pair{to, from}]; ok {
+		return new(big.Rat).Inv(rate), true
+	}
+	return nil, false
+}
@@ -0,0 +1,107 @@
+// Package ical writes iCalendar files (RFC 5545) that calendar apps can
+// import.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	prodID = "-//plann.er//go-plann.er//EN"
+
+	// maxLineOctets is the longest a content line may be before it has to be
+	// folded onto the next one.
+	maxLineOctets = 75
+
+	timeLayout = "20060102T150405Z"
+)
+
+// Event is a VEVENT. An event without an End is a point in time.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+}
+
+// Calendar is a VCALENDAR. Stamp is written as the DTSTAMP of every event.
+type Calendar struct {
+	Name   string
+	Stamp  time.Time
+	Events []Event
+}
+
+// Write writes c to w with CRLF line endings, escaping text values and folding
+// long lines.
+func (c Calendar) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", prodID)
+	line("CALSCALE", "GREGORIAN")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	for _, event := range c.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", event.UID)
+		line("DTSTAMP", formatTime(c.Stamp))
+		line("DTSTART", formatTime(event.Start))
+		if !event.End.IsZero() {
+			line("DTEND", formatTime(event.End))
+		}
+		line("SUMMARY", escape(event.Summary))
+		if event.Location != "" {
+			line("LOCATION", escape(event.Location))
+		}
+		if event.Description != "" {
+			line("DESCRIPTION", escape(event.Description))
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// writeLine writes a content line, folding it into lines of at most
+// maxLineOctets octets without splitting a UTF-8 sequence. Continuation lines
+// start with a space.
+func writeLine(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		// The leading space counts towards the length of a continuation line.
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
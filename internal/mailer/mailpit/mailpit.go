@@ -67,6 +67,8 @@ func (mp Mailpit) SendConfirmTripEmailToTripParticipant(participant ParticipantT
 	return nil
 }
 
+// SendConfirmTripEmailToTripParticipants sends one email per participant over
+// a single connection to the mail server.
 func (mp Mailpit) SendConfirmTripEmailToTripParticipants(participants []ParticipantToSendEmail, tripID uuid.UUID) error {
 	ctx := context.Background()
 	trip, err := mp.store.GetTrip(ctx, tripID)
@@ -74,12 +76,13 @@ func (mp Mailpit) SendConfirmTripEmailToTripParticipants(participants []Particip
 		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripParticipants: %w", err)
 	}
 
-	msg := mail.NewMsg()
-	if err := msg.From("mailpit@plann.er"); err != nil {
-		return fmt.Errorf("mailpit: failed to set From in email SendConfirmTripEmailToTripParticipants: %w", err)
-	}
-
+	msgs := make([]*mail.Msg, 0, len(participants))
 	for _, participant := range participants {
+		msg := mail.NewMsg()
+		if err := msg.From("mailpit@plann.er"); err != nil {
+			return fmt.Errorf("mailpit: failed to set From in email SendConfirmTripEmailToTripParticipants: %w", err)
+		}
+
 		if err := msg.To(participant.Email); err != nil {
 			return fmt.Errorf("mailpit: failed to set To in email SendConfirmTripEmailToTripParticipants: %w", err)
 		}
@@ -93,14 +96,16 @@ func (mp Mailpit) SendConfirmTripEmailToTripParticipants(participants []Particip
 			participant.Name, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
 		))
 
-		client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-		if err != nil {
-			return fmt.Errorf("mailpit: failed create email client SendConfirmTripEmailToTripParticipants: %w", err)
-		}
+		msgs = append(msgs, msg)
+	}
 
-		if err := client.DialAndSend(msg); err != nil {
-			return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripParticipants: %w", err)
-		}
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendConfirmTripEmailToTripParticipants: %w", err)
+	}
+
+	if err := client.DialAndSend(msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripParticipants: %w", err)
 	}
 
 	return nil
@@ -142,3 +147,212 @@ func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 
 	return nil
 }
+
+func (mp Mailpit) SendInviteRevokedEmailToParticipant(participant ParticipantToSendEmail, tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendInviteRevokedEmailToParticipant: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@plann.er"); err != nil {
+		return fmt.Errorf("mailpit: failed to set From in email SendInviteRevokedEmailToParticipant: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set To in email SendInviteRevokedEmailToParticipant: %w", err)
+	}
+
+	msg.Subject("Your trip invitation was revoked")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+        Hello, %s!
+        Your invitation to the trip to %s starting on %s was revoked.
+        Links from earlier invitation emails no longer work.
+        `,
+		participant.Name, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+	))
+
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendInviteRevokedEmailToParticipant: %w", err)
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendInviteRevokedEmailToParticipant: %w", err)
+	}
+
+	return nil
+}
+
+func (mp Mailpit) SendPromotedFromWaitlistEmailToParticipant(participant ParticipantToSendEmail, tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendPromotedFromWaitlistEmailToParticipant: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@plann.er"); err != nil {
+		return fmt.Errorf("mailpit: failed to set From in email SendPromotedFromWaitlistEmailToParticipant: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set To in email SendPromotedFromWaitlistEmailToParticipant: %w", err)
+	}
+
+	msg.Subject("A seat opened up on your trip")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+        Hello, %s!
+        A seat opened up on the trip to %s starting on %s.
+        You were next on the waitlist, so your place on the trip is now confirmed.
+        `,
+		participant.Name, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+	))
+
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendPromotedFromWaitlistEmailToParticipant: %w", err)
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendPromotedFromWaitlistEmailToParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// BudgetAlert describes a budget category that went past its alert
+// threshold. Amounts are already formatted with their currency.
+type BudgetAlert struct {
+	Category  string
+	Spent     string
+	Budget    string
+	Threshold int
+}
+
+func (mp Mailpit) SendBudgetAlertEmailToTripOwner(alert BudgetAlert, tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendBudgetAlertEmailToTripOwner: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@plann.er"); err != nil {
+		return fmt.Errorf("mailpit: failed to set From in email SendBudgetAlertEmailToTripOwner: %w", err)
+	}
+
+	if err := msg.To(trip.OwnerEmail); err != nil {
+		return fmt.Errorf("mailpit: failed to set To in email SendBudgetAlertEmailToTripOwner: %w", err)
+	}
+
+	msg.Subject(fmt.Sprintf("Your %s budget is %d%% spent", alert.Category, alert.Threshold))
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+        Hello, %s!
+        Your trip to %s starting on %s has spent %s of its %s budget of %s.
+        That is past the %d%% alert you set for the category.
+        `,
+		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+		alert.Spent, alert.Category, alert.Budget, alert.Threshold,
+	))
+
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendBudgetAlertEmailToTripOwner: %w", err)
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendBudgetAlertEmailToTripOwner: %w", err)
+	}
+
+	return nil
+}
+
+// TaskReminder describes a task that is past its due date.
+type TaskReminder struct {
+	Title string
+	DueOn time.Time
+}
+
+func (mp Mailpit) SendTaskReminderEmail(recipient ParticipantToSendEmail, task TaskReminder, tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTaskReminderEmail: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@plann.er"); err != nil {
+		return fmt.Errorf("mailpit: failed to set From in email SendTaskReminderEmail: %w", err)
+	}
+
+	if err := msg.To(recipient.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set To in email SendTaskReminderEmail: %w", err)
+	}
+
+	msg.Subject(fmt.Sprintf("Overdue task: %s", task.Title))
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+        Hello, %s!
+        The task "%s" for the trip to %s starting on %s was due on %s and isn't done yet.
+        `,
+		recipient.Name, task.Title, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+		task.DueOn.Format(time.DateOnly),
+	))
+
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendTaskReminderEmail: %w", err)
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTaskReminderEmail: %w", err)
+	}
+
+	return nil
+}
+
+// CommentMention describes a comment that mentions the recipient.
+type CommentMention struct {
+	Author string
+	Body   string
+}
+
+func (mp Mailpit) SendCommentMentionEmail(recipient ParticipantToSendEmail, mention CommentMention, tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendCommentMentionEmail: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@plann.er"); err != nil {
+		return fmt.Errorf("mailpit: failed to set From in email SendCommentMentionEmail: %w", err)
+	}
+
+	if err := msg.To(recipient.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set To in email SendCommentMentionEmail: %w", err)
+	}
+
+	msg.Subject(fmt.Sprintf("%s mentioned you on the trip to %s", mention.Author, trip.Destination))
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+        Hello, %s!
+        %s mentioned you in a comment on the trip to %s starting on %s:
+
+        %s
+        `,
+		recipient.Name, mention.Author, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+		mention.Body,
+	))
+
+	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendCommentMentionEmail: %w", err)
+	}
+
+	if err := client.DialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendCommentMentionEmail: %w", err)
+	}
+
+	return nil
+}
@@ -9,6 +9,41 @@ import (
 	"context"
 )
 
+// iteratorForCreateExpenseSplits implements pgx.CopyFromSource.
+type iteratorForCreateExpenseSplits struct {
+	rows                 []CreateExpenseSplitsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateExpenseSplits) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateExpenseSplits) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ExpenseID,
+		r.rows[0].ParticipantID,
+		r.rows[0].Shares,
+		r.rows[0].Amount,
+	}, nil
+}
+
+func (r iteratorForCreateExpenseSplits) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateExpenseSplits(ctx context.Context, arg []CreateExpenseSplitsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"expense_splits"}, []string{"expense_id", "participant_id", "shares", "amount"}, &iteratorForCreateExpenseSplits{rows: arg})
+}
+
 // iteratorForInviteParticipantsToTrip implements pgx.CopyFromSource.
 type iteratorForInviteParticipantsToTrip struct {
 	rows                 []InviteParticipantsToTripParams
@@ -31,6 +66,7 @@ func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].Name,
 	}, nil
 }
 
@@ -39,5 +75,5 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email", "name"}, &iteratorForInviteParticipantsToTrip{rows: arg})
 }
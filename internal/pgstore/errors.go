@@ -0,0 +1,70 @@
+package pgstore
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrJoinLinkUnusable is returned when joining through a link that was
+	// revoked, has expired or has no uses left.
+	ErrJoinLinkUnusable = errors.New("pgstore: join link is no longer usable")
+
+	// ErrAlreadyParticipant is returned when someone joins a trip they are
+	// already part of.
+	ErrAlreadyParticipant = errors.New("pgstore: already a participant of the trip")
+
+	// ErrActivityFull is returned when signing up for an activity that has
+	// reached its capacity.
+	ErrActivityFull = errors.New("pgstore: activity is full")
+
+	// ErrNotAttending is returned when leaving an activity the participant
+	// never signed up for.
+	ErrNotAttending = errors.New("pgstore: participant is not attending the activity")
+
+	// ErrPollClosed is returned when changing a poll that was already closed.
+	ErrPollClosed = errors.New("pgstore: poll is closed")
+
+	// ErrPollEmpty is returned when closing a poll that has no options.
+	ErrPollEmpty = errors.New("pgstore: poll has no options")
+
+	// ErrUnknownPollOption is returned when voting for an option that isn't
+	// part of the poll.
+	ErrUnknownPollOption = errors.New("pgstore: option is not part of the poll")
+
+	// ErrVersionMismatch is returned when a row changed since the version the
+	// caller read.
+	ErrVersionMismatch = errors.New("pgstore: row was modified concurrently")
+
+	// ErrUnknownTripLeg is returned when updating a leg that isn't part of the
+	// trip.
+	ErrUnknownTripLeg = errors.New("pgstore: leg is not part of the trip")
+
+	// ErrParticipantHasExpenses is returned when removing a participant who
+	// paid for or shares in expenses of the trip.
+	ErrParticipantHasExpenses = errors.New("pgstore: participant has expenses")
+
+	// ErrRouteOutsideTrip is returned when new trip dates would leave some of
+	// its legs outside of the trip.
+	ErrRouteOutsideTrip = errors.New("pgstore: trip legs fall outside the trip dates")
+)
+
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// IsForeignKeyViolation reports whether err was caused by a row referencing a
+// parent that doesn't exist, e.g. inserting an activity for an unknown trip.
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation
+}
+
+// IsUniqueViolation reports whether err was caused by inserting a row that
+// already exists, e.g. inviting the same email to a trip twice.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
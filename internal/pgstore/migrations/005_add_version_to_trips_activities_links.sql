@@ -0,0 +1,9 @@
+ALTER TABLE trips ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE activities ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE links ADD COLUMN IF NOT EXISTS "version" INTEGER NOT NULL DEFAULT 1;
+
+---- create above / drop below ----
+
+ALTER TABLE links DROP COLUMN IF EXISTS "version";
+ALTER TABLE activities DROP COLUMN IF EXISTS "version";
+ALTER TABLE trips DROP COLUMN IF EXISTS "version";
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    "key"           VARCHAR(255)    PRIMARY KEY NOT NULL,
+    "request_hash"  VARCHAR(64)                 NOT NULL,
+    "status_code"   INTEGER,
+    "content_type"  VARCHAR(255),
+    "response_body" BYTEA,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW()
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,15 @@
+-- Emails are matched case-insensitively, like invites and joins do. Of the
+-- duplicates, a confirmed participant is kept over an unconfirmed one, then
+-- the first row stored.
+DELETE FROM participants a
+USING participants b
+WHERE
+    a.trip_id = b.trip_id AND
+    lower(a.email) = lower(b.email) AND
+    (b.is_confirmed, a.ctid) > (a.is_confirmed, b.ctid);
+
+CREATE UNIQUE INDEX IF NOT EXISTS participants_trip_id_email_key ON participants (trip_id, lower(email));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_trip_id_email_key;
@@ -0,0 +1,6 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "name" VARCHAR(255);
+
+---- create above / drop below ----
+
+ALTER TABLE participants DROP COLUMN IF EXISTS "name";
@@ -0,0 +1,9 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invite_count"     INTEGER     NOT NULL    DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "last_invited_at"  TIMESTAMP;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invite_count",
+    DROP COLUMN IF EXISTS "last_invited_at";
@@ -0,0 +1,7 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "role" VARCHAR(32) NOT NULL DEFAULT 'editor'
+        CHECK ("role" IN ('editor', 'viewer'));
+
+---- create above / drop below ----
+
+ALTER TABLE participants DROP COLUMN IF EXISTS "role";
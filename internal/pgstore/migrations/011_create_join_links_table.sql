@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS join_links (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "code"          VARCHAR(32)     UNIQUE      NOT NULL,
+    "role"          VARCHAR(32)                 NOT NULL    DEFAULT 'editor'
+        CHECK ("role" IN ('editor', 'viewer')),
+    "max_uses"      INTEGER,
+    "uses"          INTEGER                     NOT NULL    DEFAULT 0,
+    "expires_at"    TIMESTAMP,
+    "revoked_at"    TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS join_links;
@@ -0,0 +1,11 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "capacity" INTEGER CHECK ("capacity" > 0);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "waitlisted_at" TIMESTAMP;
+
+---- create above / drop below ----
+
+ALTER TABLE participants DROP COLUMN IF EXISTS "waitlisted_at";
+
+ALTER TABLE trips DROP COLUMN IF EXISTS "capacity";
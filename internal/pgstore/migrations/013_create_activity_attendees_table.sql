@@ -0,0 +1,24 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "capacity" INTEGER CHECK ("capacity" > 0);
+
+CREATE TABLE IF NOT EXISTS activity_attendees (
+    "activity_id"       uuid                        NOT NULL,
+    "participant_id"    uuid                        NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (activity_id, participant_id),
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS activity_attendees;
+
+ALTER TABLE activities DROP COLUMN IF EXISTS "capacity";
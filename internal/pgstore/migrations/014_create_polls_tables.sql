@@ -0,0 +1,74 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "status" VARCHAR(32) NOT NULL DEFAULT 'scheduled'
+        CHECK ("status" IN ('proposed', 'scheduled', 'rejected'));
+
+CREATE TABLE IF NOT EXISTS polls (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "kind"          VARCHAR(32)                 NOT NULL
+        CHECK ("kind" IN ('activity', 'dates')),
+    "title"         VARCHAR(255)                NOT NULL,
+    "winner_id"     uuid,
+    "closed_at"     TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "poll_id"       uuid                        NOT NULL,
+    "activity_id"   uuid,
+    "starts_at"     TIMESTAMP,
+    "ends_at"       TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    CHECK (("activity_id" IS NULL) = ("starts_at" IS NOT NULL AND "ends_at" IS NOT NULL)),
+
+    FOREIGN KEY (poll_id) REFERENCES polls(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+ALTER TABLE polls
+    ADD FOREIGN KEY (winner_id) REFERENCES poll_options(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    "option_id"         uuid                    NOT NULL,
+    "participant_id"    uuid                    NOT NULL,
+    "vote"              SMALLINT
+        CHECK ("vote" IN (-1, 1)),
+    "rank"              INTEGER
+        CHECK ("rank" > 0),
+
+    PRIMARY KEY (option_id, participant_id),
+    CHECK ("vote" IS NOT NULL OR "rank" IS NOT NULL),
+
+    FOREIGN KEY (option_id) REFERENCES poll_options(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS poll_votes;
+
+ALTER TABLE polls DROP COLUMN IF EXISTS "winner_id";
+
+DROP TABLE IF EXISTS poll_options;
+
+DROP TABLE IF EXISTS polls;
+
+ALTER TABLE activities DROP COLUMN IF EXISTS "status";
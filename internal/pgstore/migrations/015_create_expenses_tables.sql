@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS expenses (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "payer_id"      uuid                        NOT NULL,
+    "activity_id"   uuid,
+    "description"   VARCHAR(255)                NOT NULL,
+    "amount"        BIGINT                      NOT NULL
+        CHECK ("amount" > 0),
+    "currency"      CHAR(3)                     NOT NULL,
+    "split_mode"    VARCHAR(32)                 NOT NULL
+        CHECK ("split_mode" IN ('equal', 'shares', 'exact')),
+    "spent_at"      TIMESTAMP                   NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    -- Participants who paid or owe something stay, so the ledger doesn't change
+    -- behind the trip's back.
+    FOREIGN KEY (payer_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS expense_splits (
+    "expense_id"        uuid                    NOT NULL,
+    "participant_id"    uuid                    NOT NULL,
+    "shares"            INTEGER
+        CHECK ("shares" > 0),
+    "amount"            BIGINT                  NOT NULL
+        CHECK ("amount" >= 0),
+
+    PRIMARY KEY (expense_id, participant_id),
+
+    FOREIGN KEY (expense_id) REFERENCES expenses(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE RESTRICT
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS expense_splits;
+
+DROP TABLE IF EXISTS expenses;
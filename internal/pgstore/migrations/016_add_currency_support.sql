@@ -0,0 +1,22 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "home_currency" CHAR(3);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    "base"          CHAR(3)                     NOT NULL,
+    "quote"         CHAR(3)                     NOT NULL,
+    "rate"          NUMERIC(24, 12)             NOT NULL
+        CHECK ("rate" > 0),
+    "effective_on"  DATE                        NOT NULL,
+    "source"        VARCHAR(32)                 NOT NULL,
+    "updated_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (base, quote, effective_on),
+
+    CHECK ("base" <> "quote")
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE trips DROP COLUMN IF EXISTS "home_currency";
@@ -0,0 +1,52 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "estimated_cost" BIGINT CHECK ("estimated_cost" > 0),
+    ADD COLUMN IF NOT EXISTS "estimated_currency" CHAR(3),
+    ADD CONSTRAINT activities_estimate_check
+        CHECK (("estimated_cost" IS NULL) = ("estimated_currency" IS NULL));
+
+ALTER TABLE expenses
+    ADD COLUMN IF NOT EXISTS "category" VARCHAR(32) NOT NULL DEFAULT 'other'
+        CHECK ("category" IN ('lodging', 'food', 'transport', 'activities', 'other'));
+
+UPDATE expenses SET "category" = 'activities' WHERE "activity_id" IS NOT NULL;
+
+-- Budgets are amounts in minor units of the home currency of their trip,
+-- which they reference so it can't change under them.
+ALTER TABLE trips
+    ADD CONSTRAINT trips_id_home_currency_key UNIQUE (id, home_currency);
+
+CREATE TABLE IF NOT EXISTS budgets (
+    "trip_id"           uuid                    NOT NULL,
+    "category"          VARCHAR(32)             NOT NULL
+        CHECK ("category" IN ('lodging', 'food', 'transport', 'activities', 'other')),
+    "amount"            BIGINT                  NOT NULL
+        CHECK ("amount" > 0),
+    "currency"          CHAR(3)                 NOT NULL,
+    "alert_threshold"   SMALLINT                NOT NULL    DEFAULT 80
+        CHECK ("alert_threshold" BETWEEN 1 AND 100),
+    "alerted_at"        TIMESTAMP,
+    "updated_at"        TIMESTAMP               NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (trip_id, category),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (trip_id, currency) REFERENCES trips(id, home_currency)
+        ON UPDATE RESTRICT
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS budgets;
+
+ALTER TABLE trips DROP CONSTRAINT IF EXISTS trips_id_home_currency_key;
+
+ALTER TABLE expenses DROP COLUMN IF EXISTS "category";
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_estimate_check,
+    DROP COLUMN IF EXISTS "estimated_currency",
+    DROP COLUMN IF EXISTS "estimated_cost";
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS reservations (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "kind"              VARCHAR(32)                 NOT NULL
+        CHECK ("kind" IN ('lodging', 'flight', 'train', 'car_rental')),
+    "provider"          VARCHAR(255),
+    "confirmation_code" VARCHAR(64),
+    "number"            VARCHAR(32),
+    "starts_at"         TIMESTAMP                   NOT NULL,
+    "ends_at"           TIMESTAMP                   NOT NULL,
+    "start_location"    VARCHAR(255)                NOT NULL,
+    "end_location"      VARCHAR(255),
+    "cost"              BIGINT
+        CHECK ("cost" > 0),
+    "cost_currency"     CHAR(3),
+    "version"           INTEGER                     NOT NULL    DEFAULT 1,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    CHECK ("ends_at" > "starts_at"),
+    CHECK (("cost" IS NULL) = ("cost_currency" IS NULL)),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS reservations;
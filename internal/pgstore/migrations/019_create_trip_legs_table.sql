@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS trip_legs (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "position"      INTEGER                     NOT NULL
+        CHECK ("position" >= 0),
+    "destination"   VARCHAR(255)                NOT NULL,
+    "starts_on"     DATE                        NOT NULL,
+    "ends_on"       DATE                        NOT NULL,
+    "timezone"      VARCHAR(64)                 NOT NULL,
+
+    CHECK ("ends_on" >= "starts_on"),
+
+    -- Deferred so a new route can reorder legs in place.
+    UNIQUE (trip_id, position) DEFERRABLE INITIALLY DEFERRED,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "leg_id" uuid REFERENCES trip_legs(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE activities DROP COLUMN IF EXISTS "leg_id";
+
+DROP TABLE IF EXISTS trip_legs;
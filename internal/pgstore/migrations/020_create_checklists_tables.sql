@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS checklists (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    -- Set on personal checklists, shared checklists belong to everyone.
+    "participant_id"    uuid,
+    "title"             VARCHAR(255)                NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS checklist_items (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "checklist_id"  uuid                        NOT NULL,
+    "position"      INTEGER                     NOT NULL,
+    "text"          VARCHAR(255)                NOT NULL,
+    "assignee_id"   uuid,
+    "checked_at"    TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (checklist_id) REFERENCES checklists(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (assignee_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    -- Templates follow the owner from one trip to the next.
+    "owner_email"   VARCHAR(255)                NOT NULL,
+    "title"         VARCHAR(255)                NOT NULL,
+    "items"         TEXT[]                      NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW()
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS checklist_templates;
+
+DROP TABLE IF EXISTS checklist_items;
+
+DROP TABLE IF EXISTS checklists;
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS tasks (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "title"         VARCHAR(255)                NOT NULL,
+    "description"   TEXT,
+    "assignee_id"   uuid,
+    "due_on"        DATE,
+    "status"        VARCHAR(16)                 NOT NULL    DEFAULT 'todo'
+        CHECK ("status" IN ('todo', 'in_progress', 'done')),
+    -- Set once the reminder for the current due date went out.
+    "reminded_at"   TIMESTAMP,
+    "version"       INTEGER                     NOT NULL    DEFAULT 1,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (assignee_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS task_comments (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "task_id"       uuid                        NOT NULL,
+    "author_id"     uuid,
+    "body"          TEXT                        NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (task_id) REFERENCES tasks(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (author_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS task_comments;
+
+DROP TABLE IF EXISTS tasks;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS comments (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "activity_id"   uuid,
+    "link_id"       uuid,
+    "parent_id"     uuid,
+    "author_id"     uuid,
+    "body"          TEXT                        NOT NULL,
+    "version"       INTEGER                     NOT NULL    DEFAULT 1,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+    "edited_at"     TIMESTAMP,
+    -- Deleted comments keep their place so the replies stay threaded.
+    "deleted_at"    TIMESTAMP,
+
+    CHECK ("activity_id" IS NULL OR "link_id" IS NULL),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (link_id) REFERENCES links(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (parent_id) REFERENCES comments(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (author_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,123 @@
+CREATE TABLE IF NOT EXISTS trip_events (
+    "id"            BIGSERIAL       PRIMARY KEY NOT NULL,
+    "trip_id"       uuid                        NOT NULL,
+    "type"          VARCHAR(32)                 NOT NULL,
+    "subject_id"    uuid                        NOT NULL,
+    -- Columns that changed, for updates.
+    "fields"        TEXT[],
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+    -- The transaction that logged the event. Ids are taken in the order rows
+    -- are inserted, not committed, so events are read in the order of their
+    -- transactions instead, see trip_events_horizon.
+    "xact_id"       BIGINT                      NOT NULL    DEFAULT pg_current_xact_id()::text::bigint,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_events_trip_id_xact_id_id_idx ON trip_events (trip_id, xact_id, id);
+
+-- trip_events_horizon is the oldest transaction still running. Every event
+-- logged by an older one is committed or rolled back for good, and any event
+-- yet to show up has an xact_id of at least the horizon. Reading events below
+-- it in (xact_id, id) order then never skips one that commits late.
+CREATE OR REPLACE FUNCTION trip_events_horizon() RETURNS bigint AS $$
+    SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint;
+$$ LANGUAGE sql STABLE;
+
+-- record_trip_event logs a change to a row of the table it is attached to, as
+-- an event of the kind named by its argument.
+CREATE OR REPLACE FUNCTION record_trip_event() RETURNS trigger AS $$
+DECLARE
+    data    jsonb;
+    changed TEXT[];
+    trip    uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        data := to_jsonb(OLD);
+    ELSE
+        data := to_jsonb(NEW);
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        SELECT array_agg(n.key ORDER BY n.key) INTO changed
+        FROM jsonb_each(data) AS n
+        WHERE to_jsonb(OLD) -> n.key IS DISTINCT FROM n.value;
+
+        -- Updates that only touched bookkeeping columns, such as a version
+        -- bump, change nothing anyone sees. Sign-ups to activities and new
+        -- routes only bump a version here, the transactions making them log
+        -- their events themselves.
+        IF changed IS NULL OR changed <@ ARRAY['version', 'updated_at'] THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    trip := COALESCE(data ->> 'trip_id', data ->> 'id')::uuid;
+
+    -- Rows deleted along with their trip have nowhere left to be logged.
+    IF NOT EXISTS (SELECT 1 FROM trips WHERE id = trip) THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO trip_events (trip_id, type, subject_id, fields)
+    VALUES (
+        trip,
+        TG_ARGV[0] || '.' || CASE TG_OP WHEN 'INSERT' THEN 'created' WHEN 'UPDATE' THEN 'updated' ELSE 'deleted' END,
+        (data ->> 'id')::uuid,
+        changed
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- notify_trip_event tells every planner instance listening on trip_events
+-- which trip has new events. Notifications are only delivered on commit.
+CREATE OR REPLACE FUNCTION notify_trip_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('trip_events', NEW.trip_id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trips_record_trip_event
+    AFTER UPDATE ON trips
+    FOR EACH ROW EXECUTE FUNCTION record_trip_event('trip');
+
+CREATE TRIGGER activities_record_trip_event
+    AFTER INSERT OR UPDATE OR DELETE ON activities
+    FOR EACH ROW EXECUTE FUNCTION record_trip_event('activity');
+
+CREATE TRIGGER links_record_trip_event
+    AFTER INSERT OR UPDATE OR DELETE ON links
+    FOR EACH ROW EXECUTE FUNCTION record_trip_event('link');
+
+CREATE TRIGGER participants_record_trip_event
+    AFTER INSERT OR UPDATE OR DELETE ON participants
+    FOR EACH ROW EXECUTE FUNCTION record_trip_event('participant');
+
+CREATE TRIGGER trip_events_notify
+    AFTER INSERT ON trip_events
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_event();
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS trip_events_notify ON trip_events;
+
+DROP TRIGGER IF EXISTS participants_record_trip_event ON participants;
+
+DROP TRIGGER IF EXISTS links_record_trip_event ON links;
+
+DROP TRIGGER IF EXISTS activities_record_trip_event ON activities;
+
+DROP TRIGGER IF EXISTS trips_record_trip_event ON trips;
+
+DROP FUNCTION IF EXISTS notify_trip_event();
+
+DROP FUNCTION IF EXISTS record_trip_event();
+
+DROP FUNCTION IF EXISTS trip_events_horizon();
+
+DROP TABLE IF EXISTS trip_events;
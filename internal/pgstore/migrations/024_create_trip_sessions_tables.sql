@@ -0,0 +1,80 @@
+-- One row per collaboration channel open on a trip, kept alive by heartbeats.
+CREATE TABLE IF NOT EXISTS trip_sessions (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "participant_id"    uuid                        NOT NULL,
+    "seen_at"           TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_sessions_trip_id_idx ON trip_sessions (trip_id);
+
+-- Soft locks on the activities being edited. A lock past expires_at is free to
+-- be taken.
+CREATE TABLE IF NOT EXISTS activity_locks (
+    "activity_id"   uuid            PRIMARY KEY NOT NULL,
+    "trip_id"       uuid                        NOT NULL,
+    "session_id"    uuid                        NOT NULL,
+    "expires_at"    TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (session_id) REFERENCES trip_sessions(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+-- notify_trip_presence tells every planner instance listening on trip_presence
+-- which trip had someone join, leave, lock or unlock.
+CREATE OR REPLACE FUNCTION notify_trip_presence() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('trip_presence', OLD.trip_id::text);
+    ELSE
+        PERFORM pg_notify('trip_presence', NEW.trip_id::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trip_sessions_notify
+    AFTER INSERT OR DELETE ON trip_sessions
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_presence();
+
+CREATE TRIGGER activity_locks_notify
+    AFTER INSERT OR DELETE ON activity_locks
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_presence();
+
+-- Renewing a lock doesn't change who holds it.
+CREATE TRIGGER activity_locks_notify_holder
+    AFTER UPDATE ON activity_locks
+    FOR EACH ROW
+    WHEN (OLD.session_id IS DISTINCT FROM NEW.session_id)
+    EXECUTE FUNCTION notify_trip_presence();
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS activity_locks_notify_holder ON activity_locks;
+
+DROP TRIGGER IF EXISTS activity_locks_notify ON activity_locks;
+
+DROP TRIGGER IF EXISTS trip_sessions_notify ON trip_sessions;
+
+DROP FUNCTION IF EXISTS notify_trip_presence();
+
+DROP TABLE IF EXISTS activity_locks;
+
+DROP TABLE IF EXISTS trip_sessions;
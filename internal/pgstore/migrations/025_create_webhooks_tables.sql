@@ -0,0 +1,90 @@
+-- Webhooks without a trip get the events of every trip of their owner.
+CREATE TABLE IF NOT EXISTS webhooks (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "owner_email"   VARCHAR(255)                NOT NULL,
+    "trip_id"       uuid,
+    "url"           TEXT                        NOT NULL,
+    -- Event types to deliver, every type when empty.
+    "events"        TEXT[]                      NOT NULL    DEFAULT '{}',
+    "secret"        TEXT                        NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_trip_id_idx ON webhooks (trip_id);
+
+CREATE INDEX IF NOT EXISTS webhooks_owner_email_idx ON webhooks (lower(owner_email)) WHERE trip_id IS NULL;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "webhook_id"        uuid                        NOT NULL,
+    "event_id"          BIGINT                      NOT NULL,
+    "status"            VARCHAR(16)                 NOT NULL    DEFAULT 'pending'
+        CHECK ("status" IN ('pending', 'succeeded', 'failed')),
+    "attempts"          INTEGER                     NOT NULL    DEFAULT 0,
+    "next_attempt_at"   TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (webhook_id) REFERENCES webhooks(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (event_id) REFERENCES trip_events(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at);
+
+CREATE TABLE IF NOT EXISTS webhook_attempts (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "delivery_id"   uuid                        NOT NULL,
+    "status_code"   INTEGER,
+    "error"         TEXT,
+    "duration_ms"   INTEGER                     NOT NULL,
+    "attempted_at"  TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_attempts_delivery_id_idx ON webhook_attempts (delivery_id);
+
+-- queue_webhook_deliveries queues a delivery of a trip event to every webhook
+-- subscribed to it, in the transaction that logged the event: the webhooks of
+-- the trip and those of its owner for every trip. Owners are told apart by
+-- email, case-insensitively like participants.
+CREATE OR REPLACE FUNCTION queue_webhook_deliveries() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO webhook_deliveries (webhook_id, event_id)
+    SELECT w.id, NEW.id
+    FROM webhooks w
+    WHERE
+        (w.trip_id = NEW.trip_id
+            OR (w.trip_id IS NULL AND lower(w.owner_email) = (SELECT lower(owner_email) FROM trips WHERE id = NEW.trip_id)))
+        AND (cardinality(w.events) = 0 OR NEW.type = ANY (w.events));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trip_events_queue_webhook_deliveries
+    AFTER INSERT ON trip_events
+    FOR EACH ROW EXECUTE FUNCTION queue_webhook_deliveries();
+
+---- create above / drop below ----
+
+DROP TRIGGER IF EXISTS trip_events_queue_webhook_deliveries ON trip_events;
+
+DROP FUNCTION IF EXISTS queue_webhook_deliveries();
+
+DROP TABLE IF EXISTS webhook_attempts;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhooks;
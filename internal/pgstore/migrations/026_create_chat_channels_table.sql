@@ -0,0 +1,35 @@
+-- Chat channels post the events of a trip to the incoming webhook of a chat
+-- platform, in order, picking up after the last event they got to.
+CREATE TABLE IF NOT EXISTS chat_channels (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "name"          VARCHAR(255)                NOT NULL,
+    "platform"      VARCHAR(16)                 NOT NULL
+        CHECK ("platform" IN ('slack', 'discord')),
+    "url"           TEXT                        NOT NULL,
+    -- Event types to post, every type when empty.
+    "events"        TEXT[]                      NOT NULL    DEFAULT '{}',
+    -- The last trip event posted or passed over, and its transaction. Events
+    -- are read in (xact_id, id) order, see migration 023.
+    "last_event_xact_id" BIGINT                 NOT NULL    DEFAULT 0,
+    "last_event_id" BIGINT                      NOT NULL    DEFAULT 0,
+    -- Failed attempts at posting the event after the last one.
+    "failures"      INTEGER                     NOT NULL    DEFAULT 0,
+    "retry_at"      TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+    "last_error"    TEXT,
+    -- Set once the platform said the incoming webhook is gone.
+    "disabled_at"   TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS chat_channels_trip_id_idx ON chat_channels (trip_id);
+
+CREATE INDEX IF NOT EXISTS chat_channels_retry_at_idx ON chat_channels (retry_at) WHERE disabled_at IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS chat_channels;
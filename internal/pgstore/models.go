@@ -10,32 +10,297 @@ import (
 )
 
 type Activity struct {
-	ID       uuid.UUID
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	ID                uuid.UUID
+	TripID            uuid.UUID
+	Title             string
+	OccursAt          pgtype.Timestamp
+	Version           int32
+	Capacity          pgtype.Int4
+	Status            string
+	EstimatedCost     pgtype.Int8
+	EstimatedCurrency pgtype.Text
+	LegID             pgtype.UUID
+}
+
+type ActivityAttendee struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	CreatedAt     pgtype.Timestamp
+}
+
+type ActivityLock struct {
+	ActivityID uuid.UUID
+	TripID     uuid.UUID
+	SessionID  uuid.UUID
+	ExpiresAt  pgtype.Timestamp
+}
+
+type Budget struct {
+	TripID         uuid.UUID
+	Category       string
+	Amount         int64
+	Currency       string
+	AlertThreshold int16
+	AlertedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type ChatChannel struct {
+	ID              uuid.UUID
+	TripID          uuid.UUID
+	Name            string
+	Platform        string
+	Url             string
+	Events          []string
+	LastEventXactID int64
+	LastEventID     int64
+	Failures        int32
+	RetryAt         pgtype.Timestamp
+	LastError       pgtype.Text
+	DisabledAt      pgtype.Timestamp
+	CreatedAt       pgtype.Timestamp
+}
+
+type Checklist struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Title         string
+	CreatedAt     pgtype.Timestamp
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID
+	ChecklistID uuid.UUID
+	Position    int32
+	Text        string
+	AssigneeID  pgtype.UUID
+	CheckedAt   pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
+}
+
+type ChecklistTemplate struct {
+	ID         uuid.UUID
+	OwnerEmail string
+	Title      string
+	Items      []string
+	CreatedAt  pgtype.Timestamp
+}
+
+type Comment struct {
+	ID         uuid.UUID
+	TripID     uuid.UUID
+	ActivityID pgtype.UUID
+	LinkID     pgtype.UUID
+	ParentID   pgtype.UUID
+	AuthorID   pgtype.UUID
+	Body       string
+	Version    int32
+	CreatedAt  pgtype.Timestamp
+	EditedAt   pgtype.Timestamp
+	DeletedAt  pgtype.Timestamp
+}
+
+type ExchangeRate struct {
+	Base        string
+	Quote       string
+	Rate        pgtype.Numeric
+	EffectiveOn pgtype.Date
+	Source      string
+	UpdatedAt   pgtype.Timestamp
+}
+
+type Expense struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	SplitMode   string
+	SpentAt     pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
+	Category    string
+}
+
+type ExpenseSplit struct {
+	ExpenseID     uuid.UUID
+	ParticipantID uuid.UUID
+	Shares        pgtype.Int4
+	Amount        int64
+}
+
+type IdempotencyKey struct {
+	Key          string
+	RequestHash  string
+	StatusCode   pgtype.Int4
+	ContentType  pgtype.Text
+	ResponseBody []byte
+	CreatedAt    pgtype.Timestamp
+}
+
+type JoinLink struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Code      string
+	Role      string
+	MaxUses   pgtype.Int4
+	Uses      int32
+	ExpiresAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
 }
 
 type Link struct {
-	ID     uuid.UUID
-	TripID uuid.UUID
-	Title  string
-	Url    string
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Title   string
+	Url     string
+	Version int32
 }
 
 type Participant struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	Email         string
+	IsConfirmed   bool
+	Name          pgtype.Text
+	InviteCount   int32
+	LastInvitedAt pgtype.Timestamp
+	Role          string
+	WaitlistedAt  pgtype.Timestamp
+}
+
+type Poll struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Kind      string
+	Title     string
+	WinnerID  pgtype.UUID
+	ClosedAt  pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+}
+
+type PollOption struct {
+	ID         uuid.UUID
+	PollID     uuid.UUID
+	ActivityID pgtype.UUID
+	StartsAt   pgtype.Timestamp
+	EndsAt     pgtype.Timestamp
+	CreatedAt  pgtype.Timestamp
+}
+
+type PollVote struct {
+	OptionID      uuid.UUID
+	ParticipantID uuid.UUID
+	Vote          pgtype.Int2
+	Rank          pgtype.Int4
+}
+
+type Reservation struct {
+	ID               uuid.UUID
+	TripID           uuid.UUID
+	Kind             string
+	Provider         pgtype.Text
+	ConfirmationCode pgtype.Text
+	Number           pgtype.Text
+	StartsAt         pgtype.Timestamp
+	EndsAt           pgtype.Timestamp
+	StartLocation    string
+	EndLocation      pgtype.Text
+	Cost             pgtype.Int8
+	CostCurrency     pgtype.Text
+	Version          int32
+	CreatedAt        pgtype.Timestamp
+}
+
+type Task struct {
 	ID          uuid.UUID
 	TripID      uuid.UUID
-	Email       string
-	IsConfirmed bool
+	Title       string
+	Description pgtype.Text
+	AssigneeID  pgtype.UUID
+	DueOn       pgtype.Date
+	Status      string
+	RemindedAt  pgtype.Timestamp
+	Version     int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type TaskComment struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	AuthorID  pgtype.UUID
+	Body      string
+	CreatedAt pgtype.Timestamp
 }
 
 type Trip struct {
+	ID           uuid.UUID
+	Destination  string
+	OwnerEmail   string
+	OwnerName    string
+	IsConfirmed  bool
+	StartsAt     pgtype.Timestamp
+	EndsAt       pgtype.Timestamp
+	Version      int32
+	Capacity     pgtype.Int4
+	HomeCurrency pgtype.Text
+}
+
+type TripEvent struct {
+	ID        int64
+	TripID    uuid.UUID
+	Type      string
+	SubjectID uuid.UUID
+	Fields    []string
+	CreatedAt pgtype.Timestamp
+	XactID    int64
+}
+
+type TripLeg struct {
 	ID          uuid.UUID
+	TripID      uuid.UUID
+	Position    int32
 	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	IsConfirmed bool
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+	StartsOn    pgtype.Date
+	EndsOn      pgtype.Date
+	Timezone    string
+}
+
+type TripSession struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	SeenAt        pgtype.Timestamp
+}
+
+type Webhook struct {
+	ID         uuid.UUID
+	OwnerEmail string
+	TripID     pgtype.UUID
+	Url        string
+	Events     []string
+	Secret     string
+	CreatedAt  pgtype.Timestamp
+}
+
+type WebhookAttempt struct {
+	ID          uuid.UUID
+	DeliveryID  uuid.UUID
+	StatusCode  pgtype.Int4
+	Error       pgtype.Text
+	DurationMs  int32
+	AttemptedAt pgtype.Timestamp
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID
+	WebhookID     uuid.UUID
+	EventID       int64
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
 }
@@ -12,10 +12,57 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const bumpTripVersion = `-- name: BumpTripVersion :execrows
+UPDATE trips
+SET
+    "version" = "version" + 1
+WHERE
+    id = $1 AND "version" = $2
+`
+
+type BumpTripVersionParams struct {
+	ID      uuid.UUID
+	Version int32
+}
+
+func (q *Queries) BumpTripVersion(ctx context.Context, arg BumpTripVersionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, bumpTripVersion, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimParticipantInvite = `-- name: ClaimParticipantInvite :execrows
+UPDATE participants
+SET
+    "invite_count" = "invite_count" + 1,
+    "last_invited_at" = NOW()
+WHERE
+    id = $1 AND
+    "invite_count" < $2 AND
+    ("last_invited_at" IS NULL OR "last_invited_at" < NOW() - $3::interval)
+`
+
+type ClaimParticipantInviteParams struct {
+	ID         uuid.UUID
+	MaxInvites int32
+	Cooldown   pgtype.Interval
+}
+
+func (q *Queries) ClaimParticipantInvite(ctx context.Context, arg ClaimParticipantInviteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimParticipantInvite, arg.ID, arg.MaxInvites, arg.Cooldown)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const confirmParticipant = `-- name: ConfirmParticipant :exec
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+UPDATE participants
+SET
+    "is_confirmed" = TRUE,
+    "waitlisted_at" = NULL
 WHERE
     id = $1
 `
@@ -25,21 +72,74 @@ func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const countConfirmedParticipants = `-- name: CountConfirmedParticipants :one
+SELECT
+    COUNT(*)
+FROM participants
+WHERE
+    trip_id = $1 AND "is_confirmed"
+`
+
+func (q *Queries) CountConfirmedParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countConfirmedParticipants, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createActivity = `-- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "capacity", "estimated_cost", "estimated_currency", "leg_id" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id"
 `
 
 type CreateActivityParams struct {
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	TripID            uuid.UUID
+	Title             string
+	OccursAt          pgtype.Timestamp
+	Capacity          pgtype.Int4
+	EstimatedCost     pgtype.Int8
+	EstimatedCurrency pgtype.Text
+	LegID             pgtype.UUID
 }
 
 func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.Capacity,
+		arg.EstimatedCost,
+		arg.EstimatedCurrency,
+		arg.LegID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createParticipant = `-- name: CreateParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email", "name", "role" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreateParticipantParams struct {
+	TripID uuid.UUID
+	Email  string
+	Name   pgtype.Text
+	Role   string
+}
+
+func (q *Queries) CreateParticipant(ctx context.Context, arg CreateParticipantParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createParticipant,
+		arg.TripID,
+		arg.Email,
+		arg.Name,
+		arg.Role,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -65,9 +165,110 @@ func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams)
 	return id, err
 }
 
+const declineParticipant = `-- name: DeclineParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = FALSE,
+    "waitlisted_at" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeclineParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, declineParticipant, id)
+	return err
+}
+
+const deleteActivity = `-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3
+`
+
+type DeleteActivityParams struct {
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Version int32
+}
+
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteParticipant(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteParticipant, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripLink = `-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3
+`
+
+type DeleteTripLinkParams struct {
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Version int32
+}
+
+func (q *Queries) DeleteTripLink(ctx context.Context, arg DeleteTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripLink, arg.ID, arg.TripID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "capacity", "status", "estimated_cost",
+    "estimated_currency", "leg_id"
+FROM activities
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type GetActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetActivity(ctx context.Context, arg GetActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.Version,
+		&i.Capacity,
+		&i.Status,
+		&i.EstimatedCost,
+		&i.EstimatedCurrency,
+		&i.LegID,
+	)
+	return i, err
+}
+
 const getParticipant = `-- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "name", "invite_count", "last_invited_at", "role",
+    "waitlisted_at"
 FROM participants
 WHERE
     id = $1
@@ -81,32 +282,80 @@ func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant
 		&i.TripID,
 		&i.Email,
 		&i.IsConfirmed,
+		&i.Name,
+		&i.InviteCount,
+		&i.LastInvitedAt,
+		&i.Role,
+		&i.WaitlistedAt,
 	)
 	return i, err
 }
 
-const getParticipants = `-- name: GetParticipants :many
+const getParticipantEmails = `-- name: GetParticipantEmails :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "email"
 FROM participants
 WHERE
     trip_id = $1
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+func (q *Queries) GetParticipantEmails(ctx context.Context, tripID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getParticipantEmails, tripID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Participant
+	var items []string
 	for rows.Next() {
-		var i Participant
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT
+    "trips"."id" AS "trip_id", "participants"."id", "participants"."email", "participants"."is_confirmed", "participants"."name",
+    "participants"."role", "participants"."waitlisted_at"
+FROM trips
+LEFT JOIN participants ON participants.trip_id = trips.id
+WHERE
+    trips.id = $1
+`
+
+type GetParticipantsRow struct {
+	TripID       uuid.UUID
+	ID           pgtype.UUID
+	Email        pgtype.Text
+	IsConfirmed  pgtype.Bool
+	Name         pgtype.Text
+	Role         pgtype.Text
+	WaitlistedAt pgtype.Timestamp
+}
+
+func (q *Queries) GetParticipants(ctx context.Context, id uuid.UUID) ([]GetParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getParticipants, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetParticipantsRow
+	for rows.Next() {
+		var i GetParticipantsRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.TripID,
+			&i.ID,
 			&i.Email,
 			&i.IsConfirmed,
+			&i.Name,
+			&i.Role,
+			&i.WaitlistedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -120,7 +369,8 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 
 const getTrip = `-- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "version",
+    "capacity", "home_currency"
 FROM trips
 WHERE
     id = $1
@@ -137,32 +387,52 @@ func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
 		&i.IsConfirmed,
 		&i.StartsAt,
 		&i.EndsAt,
+		&i.Version,
+		&i.Capacity,
+		&i.HomeCurrency,
 	)
 	return i, err
 }
 
 const getTripActivities = `-- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
-FROM activities
+    "trips"."id" AS "trip_id", "activities"."id", "activities"."title", "activities"."occurs_at", "activities"."capacity",
+    "activities"."estimated_cost", "activities"."estimated_currency", "activities"."leg_id"
+FROM trips
+LEFT JOIN activities ON activities.trip_id = trips.id AND activities.status = 'scheduled'
 WHERE
-    trip_id = $1
+    trips.id = $1
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+type GetTripActivitiesRow struct {
+	TripID            uuid.UUID
+	ID                pgtype.UUID
+	Title             pgtype.Text
+	OccursAt          pgtype.Timestamp
+	Capacity          pgtype.Int4
+	EstimatedCost     pgtype.Int8
+	EstimatedCurrency pgtype.Text
+	LegID             pgtype.UUID
+}
+
+func (q *Queries) GetTripActivities(ctx context.Context, id uuid.UUID) ([]GetTripActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, id)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Activity
+	var items []GetTripActivitiesRow
 	for rows.Next() {
-		var i Activity
+		var i GetTripActivitiesRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.TripID,
+			&i.ID,
 			&i.Title,
 			&i.OccursAt,
+			&i.Capacity,
+			&i.EstimatedCost,
+			&i.EstimatedCurrency,
+			&i.LegID,
 		); err != nil {
 			return nil, err
 		}
@@ -174,26 +444,60 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
+const getTripLink = `-- name: GetTripLink :one
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "version"
 FROM links
 WHERE
-    trip_id = $1
+    id = $1 AND trip_id = $2
+`
+
+type GetTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetTripLink(ctx context.Context, arg GetTripLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getTripLink, arg.ID, arg.TripID)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Url,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "trips"."id" AS "trip_id", "links"."id", "links"."title", "links"."url"
+FROM trips
+LEFT JOIN links ON links.trip_id = trips.id
+WHERE
+    trips.id = $1
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type GetTripLinksRow struct {
+	TripID uuid.UUID
+	ID     pgtype.UUID
+	Title  pgtype.Text
+	Url    pgtype.Text
+}
+
+func (q *Queries) GetTripLinks(ctx context.Context, id uuid.UUID) ([]GetTripLinksRow, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, id)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []GetTripLinksRow
 	for rows.Next() {
-		var i Link
+		var i GetTripLinksRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.TripID,
+			&i.ID,
 			&i.Title,
 			&i.Url,
 		); err != nil {
@@ -210,17 +514,19 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 const insertTrip = `-- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "capacity", "home_currency") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id"
 `
 
 type InsertTripParams struct {
-	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+	Destination  string
+	OwnerEmail   string
+	OwnerName    string
+	StartsAt     pgtype.Timestamp
+	EndsAt       pgtype.Timestamp
+	Capacity     pgtype.Int4
+	HomeCurrency pgtype.Text
 }
 
 func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
@@ -230,6 +536,8 @@ func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UU
 		arg.OwnerName,
 		arg.StartsAt,
 		arg.EndsAt,
+		arg.Capacity,
+		arg.HomeCurrency,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -239,34 +547,231 @@ func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UU
 type InviteParticipantsToTripParams struct {
 	TripID uuid.UUID
 	Email  string
+	Name   pgtype.Text
+}
+
+const lockTrip = `-- name: LockTrip :one
+SELECT
+    "id", "owner_email", "capacity"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+type LockTripRow struct {
+	ID         uuid.UUID
+	OwnerEmail string
+	Capacity   pgtype.Int4
+}
+
+func (q *Queries) LockTrip(ctx context.Context, id uuid.UUID) (LockTripRow, error) {
+	row := q.db.QueryRow(ctx, lockTrip, id)
+	var i LockTripRow
+	err := row.Scan(&i.ID, &i.OwnerEmail, &i.Capacity)
+	return i, err
+}
+
+const markParticipantsInvited = `-- name: MarkParticipantsInvited :exec
+UPDATE participants
+SET
+    "invite_count" = "invite_count" + 1,
+    "last_invited_at" = NOW()
+WHERE
+    trip_id = $1
+    -- Emails may be given in another case than the one they were stored in.
+    AND lower(email) IN (SELECT lower(e) FROM unnest($2::text[]) AS e)
+`
+
+type MarkParticipantsInvitedParams struct {
+	TripID uuid.UUID
+	Emails []string
+}
+
+func (q *Queries) MarkParticipantsInvited(ctx context.Context, arg MarkParticipantsInvitedParams) error {
+	_, err := q.db.Exec(ctx, markParticipantsInvited, arg.TripID, arg.Emails)
+	return err
+}
+
+const promoteWaitlistedParticipants = `-- name: PromoteWaitlistedParticipants :many
+UPDATE participants
+SET
+    "is_confirmed" = TRUE,
+    "waitlisted_at" = NULL
+WHERE
+    id IN (
+        SELECT "id"
+        FROM participants AS waitlisted
+        WHERE
+            waitlisted.trip_id = $1 AND waitlisted.waitlisted_at IS NOT NULL
+        ORDER BY waitlisted.waitlisted_at, waitlisted.id
+        LIMIT $2
+    )
+RETURNING "id", "trip_id", "email", "is_confirmed", "name", "invite_count", "last_invited_at", "role", "waitlisted_at"
+`
+
+type PromoteWaitlistedParticipantsParams struct {
+	TripID uuid.UUID
+	Limit  pgtype.Int8
+}
+
+func (q *Queries) PromoteWaitlistedParticipants(ctx context.Context, arg PromoteWaitlistedParticipantsParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, promoteWaitlistedParticipants, arg.TripID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.InviteCount,
+			&i.LastInvitedAt,
+			&i.Role,
+			&i.WaitlistedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
+const updateActivity = `-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "capacity" = $3,
+    "estimated_cost" = $4,
+    "estimated_currency" = $5,
+    "leg_id" = $6,
+    "version" = "version" + 1
+WHERE
+    id = $7 AND trip_id = $8 AND "version" = $9
+`
+
+type UpdateActivityParams struct {
+	Title             string
+	OccursAt          pgtype.Timestamp
+	Capacity          pgtype.Int4
+	EstimatedCost     pgtype.Int8
+	EstimatedCurrency pgtype.Text
+	LegID             pgtype.UUID
+	ID                uuid.UUID
+	TripID            uuid.UUID
+	Version           int32
+}
+
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.Capacity,
+		arg.EstimatedCost,
+		arg.EstimatedCurrency,
+		arg.LegID,
+		arg.ID,
+		arg.TripID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTrip = `-- name: UpdateTrip :execrows
 UPDATE trips
 SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "capacity" = $5,
+    "home_currency" = $6,
+    "version" = "version" + 1
 WHERE
-    id = $5
+    id = $7 AND "version" = $8
 `
 
 type UpdateTripParams struct {
-	Destination string
-	EndsAt      pgtype.Timestamp
-	StartsAt    pgtype.Timestamp
-	IsConfirmed bool
-	ID          uuid.UUID
+	Destination  string
+	EndsAt       pgtype.Timestamp
+	StartsAt     pgtype.Timestamp
+	IsConfirmed  bool
+	Capacity     pgtype.Int4
+	HomeCurrency pgtype.Text
+	ID           uuid.UUID
+	Version      int32
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTrip,
 		arg.Destination,
 		arg.EndsAt,
 		arg.StartsAt,
 		arg.IsConfirmed,
+		arg.Capacity,
+		arg.HomeCurrency,
 		arg.ID,
+		arg.Version,
 	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripLink = `-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "version" = "version" + 1
+WHERE
+    id = $3 AND trip_id = $4 AND "version" = $5
+`
+
+type UpdateTripLinkParams struct {
+	Title   string
+	Url     string
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Version int32
+}
+
+func (q *Queries) UpdateTripLink(ctx context.Context, arg UpdateTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripLink,
+		arg.Title,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const waitlistParticipant = `-- name: WaitlistParticipant :exec
+UPDATE participants
+SET
+    "waitlisted_at" = COALESCE("waitlisted_at", NOW())
+WHERE
+    id = $1
+`
+
+func (q *Queries) WaitlistParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, waitlistParticipant, id)
 	return err
 }
@@ -0,0 +1,60 @@
+-- name: LockActivity :one
+SELECT
+    "id", "trip_id", "capacity"
+FROM activities
+WHERE
+    id = $1 AND trip_id = $2 AND status = 'scheduled'
+FOR UPDATE;
+
+-- name: CountActivityAttendees :one
+SELECT
+    COUNT(*)
+FROM activity_attendees
+WHERE
+    activity_id = $1;
+
+-- name: AddActivityAttendee :execrows
+INSERT INTO activity_attendees
+    ( "activity_id", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveActivityAttendee :execrows
+DELETE FROM activity_attendees
+WHERE
+    activity_id = $1 AND participant_id = $2;
+
+-- name: BumpActivityVersion :exec
+UPDATE activities
+SET
+    "version" = "version" + 1
+WHERE
+    id = $1;
+
+-- name: GetActivityAttendees :many
+SELECT
+    "participants"."id", "participants"."email", "participants"."name"
+FROM activity_attendees
+JOIN participants ON participants.id = activity_attendees.participant_id
+WHERE
+    activity_attendees.activity_id = $1
+ORDER BY activity_attendees.created_at;
+
+-- name: GetTripActivityAttendees :many
+SELECT
+    "activity_attendees"."activity_id", "participants"."id", "participants"."email", "participants"."name"
+FROM activity_attendees
+JOIN participants ON participants.id = activity_attendees.participant_id
+WHERE
+    participants.trip_id = $1
+ORDER BY activity_attendees.created_at;
+
+-- name: GetParticipantItinerary :many
+SELECT
+    "participants"."trip_id", "activities"."id", "activities"."title", "activities"."occurs_at", "activities"."capacity",
+    "activities"."estimated_cost", "activities"."estimated_currency", "activities"."leg_id"
+FROM participants
+LEFT JOIN activity_attendees ON activity_attendees.participant_id = participants.id
+LEFT JOIN activities ON activities.id = activity_attendees.activity_id
+WHERE
+    participants.id = $1;
@@ -0,0 +1,37 @@
+-- name: UpsertBudget :exec
+INSERT INTO budgets
+    ( "trip_id", "category", "amount", "currency", "alert_threshold" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT ("trip_id", "category") DO UPDATE
+SET
+    "amount" = EXCLUDED."amount",
+    "currency" = EXCLUDED."currency",
+    "alert_threshold" = EXCLUDED."alert_threshold",
+    "alerted_at" = NULL,
+    "updated_at" = NOW();
+
+-- name: GetTripBudgets :many
+SELECT
+    "trip_id", "category", "amount", "currency", "alert_threshold", "alerted_at", "updated_at"
+FROM budgets
+WHERE
+    trip_id = $1;
+
+-- name: GetBudget :one
+SELECT
+    "trip_id", "category", "amount", "currency", "alert_threshold", "alerted_at", "updated_at"
+FROM budgets
+WHERE
+    trip_id = $1 AND category = $2;
+
+-- name: DeleteBudget :execrows
+DELETE FROM budgets
+WHERE
+    trip_id = $1 AND category = $2;
+
+-- name: MarkBudgetAlerted :execrows
+UPDATE budgets
+SET
+    "alerted_at" = NOW()
+WHERE
+    trip_id = $1 AND category = $2 AND "alerted_at" IS NULL;
@@ -0,0 +1,67 @@
+-- name: CreateChatChannel :one
+INSERT INTO chat_channels
+    ( "trip_id", "name", "platform", "url", "events", "last_event_xact_id" ) VALUES
+    ( @trip_id, @name, @platform, @url, @events, trip_events_horizon() )
+RETURNING "id";
+
+-- name: GetTripChatChannels :many
+SELECT
+    "id", "trip_id", "name", "platform", "url", "events", "last_event_xact_id", "last_event_id", "failures", "retry_at", "last_error", "disabled_at", "created_at"
+FROM chat_channels
+WHERE
+    trip_id = $1
+ORDER BY "created_at", "id";
+
+-- name: GetTripChatChannel :one
+SELECT
+    "id", "trip_id", "name", "platform", "url", "events", "last_event_xact_id", "last_event_id", "failures", "retry_at", "last_error", "disabled_at", "created_at"
+FROM chat_channels
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: DeleteTripChatChannel :execrows
+DELETE FROM chat_channels
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: ClaimChatChannels :many
+WITH due AS (
+    SELECT c.id
+    FROM chat_channels c
+    WHERE
+        c.disabled_at IS NULL
+        AND c.retry_at <= NOW()
+        AND EXISTS (
+            SELECT 1 FROM trip_events e
+            WHERE
+                e.trip_id = c.trip_id
+                AND (e.xact_id, e.id) > (c.last_event_xact_id, c.last_event_id)
+                AND e.xact_id < trip_events_horizon()
+        )
+    ORDER BY c.retry_at
+    LIMIT @max_results
+    FOR UPDATE SKIP LOCKED
+), claimed AS (
+    UPDATE chat_channels c
+    SET "retry_at" = NOW() + make_interval(secs => @lease_seconds::int)
+    FROM due
+    WHERE
+        c.id = due.id
+    RETURNING c."id", c."trip_id", c."platform", c."url", c."events", c."last_event_xact_id", c."last_event_id", c."failures"
+)
+SELECT
+    c."id", c."trip_id", c."platform", c."url", c."events", c."last_event_xact_id", c."last_event_id", c."failures", t."destination"
+FROM claimed c
+JOIN trips t ON t.id = c.trip_id;
+
+-- name: SaveChatChannelProgress :exec
+UPDATE chat_channels
+SET
+    "last_event_xact_id" = @last_event_xact_id,
+    "last_event_id" = @last_event_id,
+    "failures" = @failures,
+    "retry_at" = NOW() + make_interval(secs => @retry_in_seconds::int),
+    "last_error" = @last_error,
+    "disabled_at" = CASE WHEN @disable::boolean THEN NOW() ELSE "disabled_at" END
+WHERE
+    id = @id;
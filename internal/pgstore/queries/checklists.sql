@@ -0,0 +1,116 @@
+-- name: CreateChecklist :one
+INSERT INTO checklists
+    ( "trip_id", "participant_id", "title" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetTripChecklists :many
+SELECT
+    "id", "trip_id", "participant_id", "title", "created_at"
+FROM checklists
+WHERE
+    trip_id = $1
+ORDER BY "created_at", "id";
+
+-- name: GetChecklist :one
+SELECT
+    "id", "trip_id", "participant_id", "title", "created_at"
+FROM checklists
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: DeleteChecklist :execrows
+DELETE FROM checklists
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: GetTripChecklistItems :many
+SELECT
+    ci."id", ci."checklist_id", ci."position", ci."text", ci."assignee_id", ci."checked_at", ci."created_at"
+FROM checklist_items ci
+JOIN checklists c ON c.id = ci.checklist_id
+WHERE
+    c.trip_id = $1
+ORDER BY ci."checklist_id", ci."position", ci."created_at";
+
+-- name: GetChecklistItems :many
+SELECT
+    "id", "checklist_id", "position", "text", "assignee_id", "checked_at", "created_at"
+FROM checklist_items
+WHERE
+    checklist_id = $1
+ORDER BY "position", "created_at";
+
+-- name: CreateChecklistItem :one
+INSERT INTO checklist_items
+    ( "checklist_id", "position", "text", "assignee_id" ) VALUES
+    ( $1, (SELECT COALESCE(MAX("position"), 0) + 1 FROM checklist_items WHERE checklist_id = $1), $2, $3 )
+RETURNING "id";
+
+-- name: CreateChecklistItems :exec
+INSERT INTO checklist_items
+    ( "checklist_id", "position", "text" )
+SELECT
+    @checklist_id::uuid, item."position", item."text"
+FROM unnest(@texts::text[]) WITH ORDINALITY AS item("text", "position");
+
+-- name: UpdateChecklistItem :execrows
+UPDATE checklist_items
+SET
+    "text" = $1,
+    "assignee_id" = $2
+WHERE
+    id = $3 AND checklist_id = $4;
+
+-- name: CheckChecklistItem :execrows
+UPDATE checklist_items
+SET
+    "checked_at" = COALESCE("checked_at", NOW())
+WHERE
+    id = $1 AND checklist_id = $2;
+
+-- name: UncheckChecklistItem :execrows
+UPDATE checklist_items
+SET
+    "checked_at" = NULL
+WHERE
+    id = $1 AND checklist_id = $2;
+
+-- name: ReorderChecklistItems :exec
+UPDATE checklist_items
+SET
+    "position" = item."position"
+FROM unnest(@item_ids::uuid[]) WITH ORDINALITY AS item("id", "position")
+WHERE
+    checklist_items.id = item.id AND checklist_items.checklist_id = @checklist_id;
+
+-- name: DeleteChecklistItem :execrows
+DELETE FROM checklist_items
+WHERE
+    id = $1 AND checklist_id = $2;
+
+-- name: CreateChecklistTemplate :one
+INSERT INTO checklist_templates
+    ( "owner_email", "title", "items" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetChecklistTemplates :many
+SELECT
+    "id", "owner_email", "title", "items", "created_at"
+FROM checklist_templates
+WHERE
+    owner_email = $1
+ORDER BY "created_at", "id";
+
+-- name: GetChecklistTemplate :one
+SELECT
+    "id", "owner_email", "title", "items", "created_at"
+FROM checklist_templates
+WHERE
+    id = $1 AND owner_email = $2;
+
+-- name: DeleteChecklistTemplate :execrows
+DELETE FROM checklist_templates
+WHERE
+    id = $1 AND owner_email = $2;
@@ -0,0 +1,53 @@
+-- name: CreateComment :one
+INSERT INTO comments
+    ( "trip_id", "activity_id", "link_id", "parent_id", "author_id", "body" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: GetComment :one
+SELECT
+    "id", "trip_id", "activity_id", "link_id", "parent_id", "author_id", "body", "version", "created_at",
+    "edited_at", "deleted_at"
+FROM comments
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: GetComments :many
+SELECT
+    c."id", c."trip_id", c."activity_id", c."link_id", c."parent_id", c."author_id", c."body", c."version",
+    c."created_at", c."edited_at", c."deleted_at",
+    (SELECT COUNT(*) FROM comments r WHERE r.parent_id = c.id) AS reply_count
+FROM comments c
+WHERE
+    c.trip_id = @trip_id
+    AND (
+        (c.activity_id IS NOT DISTINCT FROM @activity_id
+            AND c.link_id IS NOT DISTINCT FROM @link_id
+            AND c.parent_id IS NULL
+            AND @parent_id::uuid IS NULL)
+        -- Replies share the activity or link of their parent, so a thread is
+        -- listed by its parent alone.
+        OR c.parent_id = @parent_id
+    )
+    AND (c."deleted_at" IS NULL OR EXISTS (SELECT 1 FROM comments r WHERE r.parent_id = c.id))
+    AND (c."created_at", c."id") > (@after_created_at::timestamp, @after_id::uuid)
+ORDER BY c."created_at", c."id"
+LIMIT @max_results;
+
+-- name: UpdateComment :execrows
+UPDATE comments
+SET
+    "body" = $1,
+    "edited_at" = NOW(),
+    "version" = "version" + 1
+WHERE
+    id = $2 AND trip_id = $3 AND "version" = $4 AND "deleted_at" IS NULL;
+
+-- name: DeleteComment :execrows
+UPDATE comments
+SET
+    "body" = '',
+    "deleted_at" = NOW(),
+    "version" = "version" + 1
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3 AND "deleted_at" IS NULL;
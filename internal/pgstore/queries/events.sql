@@ -0,0 +1,39 @@
+-- name: GetTripEventsHorizon :one
+SELECT trip_events_horizon()::bigint;
+
+-- name: GetTripEventXactID :one
+SELECT "xact_id"
+FROM trip_events
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: GetTripEvents :many
+SELECT
+    "id", "trip_id", "type", "subject_id", "fields", "created_at", "xact_id"
+FROM trip_events
+WHERE
+    trip_id = @trip_id
+    AND ("xact_id", "id") > (@after_xact_id::bigint, @after_id::bigint)
+    AND "xact_id" < trip_events_horizon()
+ORDER BY "xact_id", "id"
+LIMIT @max_results;
+
+-- name: RecordTripEvent :exec
+INSERT INTO trip_events
+    ( "trip_id", "type", "subject_id", "fields" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: DeleteOldTripEvents :execrows
+DELETE FROM trip_events e
+WHERE
+    e.created_at < NOW() - make_interval(secs => @max_age_seconds::int)
+    AND NOT EXISTS (
+        SELECT 1 FROM webhook_deliveries d WHERE d.event_id = e.id AND d.status = 'pending'
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM chat_channels c
+        WHERE
+            c.trip_id = e.trip_id
+            AND (c.last_event_xact_id, c.last_event_id) < (e.xact_id, e.id)
+            AND c.disabled_at IS NULL
+    );
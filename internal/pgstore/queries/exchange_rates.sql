@@ -0,0 +1,17 @@
+-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates
+    ( "base", "quote", "rate", "effective_on", "source" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT ("base", "quote", "effective_on") DO UPDATE
+SET
+    "rate" = EXCLUDED."rate",
+    "source" = EXCLUDED."source",
+    "updated_at" = NOW();
+
+-- name: GetExchangeRatesOn :many
+SELECT DISTINCT ON ("base", "quote")
+    "base", "quote", "rate", "effective_on", "source", "updated_at"
+FROM exchange_rates
+WHERE
+    effective_on <= $1
+ORDER BY "base", "quote", "effective_on" DESC;
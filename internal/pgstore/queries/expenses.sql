@@ -0,0 +1,51 @@
+-- name: CreateExpense :one
+INSERT INTO expenses
+    ( "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "split_mode", "spent_at",
+      "category" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9 )
+RETURNING "id";
+
+-- name: CreateExpenseSplits :copyfrom
+INSERT INTO expense_splits
+    ( "expense_id", "participant_id", "shares", "amount" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: GetTripExpenses :many
+SELECT
+    "trips"."id" AS "trip_id", "expenses"."id", "expenses"."payer_id", "expenses"."activity_id",
+    "expenses"."description", "expenses"."amount", "expenses"."currency", "expenses"."split_mode",
+    "expenses"."spent_at", "expenses"."category"
+FROM trips
+LEFT JOIN expenses ON expenses.trip_id = trips.id
+WHERE
+    trips.id = $1
+ORDER BY "expenses"."spent_at", "expenses"."created_at";
+
+-- name: GetTripExpenseSplits :many
+SELECT
+    "expense_splits"."expense_id", "expense_splits"."participant_id", "expense_splits"."shares",
+    "expense_splits"."amount"
+FROM expense_splits
+JOIN expenses ON expenses.id = expense_splits.expense_id
+WHERE
+    expenses.trip_id = $1;
+
+-- name: GetExpense :one
+SELECT
+    "id", "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "split_mode",
+    "spent_at", "created_at", "category"
+FROM expenses
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: GetExpenseSplits :many
+SELECT
+    "expense_id", "participant_id", "shares", "amount"
+FROM expense_splits
+WHERE
+    expense_id = $1;
+
+-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE
+    id = $1 AND trip_id = $2;
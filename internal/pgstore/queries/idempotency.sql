@@ -0,0 +1,34 @@
+-- name: CreateIdempotencyKey :execrows
+INSERT INTO idempotency_keys
+    ( "key", "request_hash" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("key") DO UPDATE
+SET
+    "request_hash" = EXCLUDED.request_hash,
+    "status_code" = NULL,
+    "content_type" = NULL,
+    "response_body" = NULL,
+    "created_at" = NOW()
+WHERE
+    idempotency_keys.created_at < NOW() - INTERVAL '24 hours';
+
+-- name: GetIdempotencyKey :one
+SELECT
+    "key", "request_hash", "status_code", "content_type", "response_body", "created_at"
+FROM idempotency_keys
+WHERE
+    key = $1;
+
+-- name: SaveIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET
+    "status_code" = $1,
+    "content_type" = $2,
+    "response_body" = $3
+WHERE
+    key = $4;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+    key = $1;
@@ -0,0 +1,42 @@
+-- name: CreateJoinLink :one
+INSERT INTO join_links
+    ( "trip_id", "code", "role", "max_uses", "expires_at" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id";
+
+-- name: GetTripJoinLinks :many
+SELECT
+    "trips"."id" AS "trip_id", "join_links"."id", "join_links"."code", "join_links"."role", "join_links"."max_uses",
+    "join_links"."uses", "join_links"."expires_at", "join_links"."revoked_at", "join_links"."created_at"
+FROM trips
+LEFT JOIN join_links ON join_links.trip_id = trips.id
+WHERE
+    trips.id = $1
+ORDER BY "join_links"."created_at";
+
+-- name: RevokeJoinLink :execrows
+UPDATE join_links
+SET
+    "revoked_at" = NOW()
+WHERE
+    id = $1 AND trip_id = $2 AND "revoked_at" IS NULL;
+
+-- name: LockJoinLink :one
+SELECT
+    "id", "trip_id", "role",
+    (
+        "revoked_at" IS NULL AND
+        ("expires_at" IS NULL OR "expires_at" > NOW()) AND
+        ("max_uses" IS NULL OR "uses" < "max_uses")
+    )::boolean AS "usable"
+FROM join_links
+WHERE
+    code = $1
+FOR UPDATE;
+
+-- name: IncrementJoinLinkUses :exec
+UPDATE join_links
+SET
+    "uses" = "uses" + 1
+WHERE
+    id = $1;
@@ -0,0 +1,123 @@
+-- name: CreatePoll :one
+INSERT INTO polls
+    ( "trip_id", "kind", "title" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: CreateProposedActivity :one
+INSERT INTO activities
+    ( "trip_id", "title", "occurs_at", "status" ) VALUES
+    ( $1, $2, $3, 'proposed' )
+RETURNING "id";
+
+-- name: CreatePollOption :one
+INSERT INTO poll_options
+    ( "poll_id", "activity_id", "starts_at", "ends_at" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetTripPolls :many
+SELECT
+    "trips"."id" AS "trip_id", "polls"."id", "polls"."kind", "polls"."title", "polls"."winner_id",
+    "polls"."closed_at", "polls"."created_at"
+FROM trips
+LEFT JOIN polls ON polls.trip_id = trips.id
+WHERE
+    trips.id = $1
+ORDER BY "polls"."created_at";
+
+-- name: GetPoll :one
+SELECT
+    "id", "trip_id", "kind", "title", "winner_id", "closed_at", "created_at"
+FROM polls
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: LockPoll :one
+SELECT
+    "id", "trip_id", "kind", "title", "winner_id", "closed_at", "created_at"
+FROM polls
+WHERE
+    id = $1 AND trip_id = $2
+FOR UPDATE;
+
+-- name: GetPollOptions :many
+SELECT
+    "poll_options"."id", "poll_options"."poll_id", "poll_options"."activity_id", "activities"."title",
+    "activities"."occurs_at", "poll_options"."starts_at", "poll_options"."ends_at",
+    COUNT(*) FILTER (WHERE "poll_votes"."vote" = 1) AS "upvotes",
+    COUNT(*) FILTER (WHERE "poll_votes"."vote" = -1) AS "downvotes",
+    COALESCE(SUM("poll_votes"."vote"), 0)::bigint AS "score",
+    COALESCE(SUM(GREATEST("option_counts"."total" + 1 - "poll_votes"."rank", 0)), 0)::bigint AS "rank_points"
+FROM poll_options
+JOIN (
+    SELECT "poll_id", COUNT(*) AS "total" FROM poll_options GROUP BY "poll_id"
+) AS option_counts ON option_counts.poll_id = poll_options.poll_id
+LEFT JOIN activities ON activities.id = poll_options.activity_id
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE
+    poll_options.poll_id = $1
+GROUP BY poll_options.id, activities.id
+ORDER BY "score" DESC, "rank_points" DESC, poll_options.created_at;
+
+-- name: GetTripPollOptions :many
+SELECT
+    "poll_options"."id", "poll_options"."poll_id", "poll_options"."activity_id", "activities"."title",
+    "activities"."occurs_at", "poll_options"."starts_at", "poll_options"."ends_at",
+    COUNT(*) FILTER (WHERE "poll_votes"."vote" = 1) AS "upvotes",
+    COUNT(*) FILTER (WHERE "poll_votes"."vote" = -1) AS "downvotes",
+    COALESCE(SUM("poll_votes"."vote"), 0)::bigint AS "score",
+    COALESCE(SUM(GREATEST("option_counts"."total" + 1 - "poll_votes"."rank", 0)), 0)::bigint AS "rank_points"
+FROM poll_options
+JOIN polls ON polls.id = poll_options.poll_id
+JOIN (
+    SELECT "poll_id", COUNT(*) AS "total" FROM poll_options GROUP BY "poll_id"
+) AS option_counts ON option_counts.poll_id = poll_options.poll_id
+LEFT JOIN activities ON activities.id = poll_options.activity_id
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE
+    polls.trip_id = $1
+GROUP BY poll_options.id, activities.id
+ORDER BY "score" DESC, "rank_points" DESC, poll_options.created_at;
+
+-- name: DeletePollVotes :exec
+DELETE FROM poll_votes
+WHERE
+    participant_id = $1 AND option_id IN (
+        SELECT "id" FROM poll_options WHERE poll_id = $2
+    );
+
+-- name: CastPollVote :execrows
+INSERT INTO poll_votes
+    ( "option_id", "participant_id", "vote", "rank" )
+SELECT
+    $1::uuid, $2::uuid, $3::smallint, $4::integer
+WHERE EXISTS (
+    SELECT 1 FROM poll_options WHERE id = $1 AND poll_id = $5
+);
+
+-- name: ScheduleActivity :exec
+UPDATE activities
+SET
+    "status" = 'scheduled',
+    "version" = "version" + 1
+WHERE
+    id = $1;
+
+-- name: RejectPollActivities :exec
+UPDATE activities
+SET
+    "status" = 'rejected',
+    "version" = "version" + 1
+WHERE
+    "status" = 'proposed' AND id IN (
+        SELECT "activity_id" FROM poll_options WHERE poll_id = $1
+    );
+
+-- name: SetPollWinner :exec
+UPDATE polls
+SET
+    "winner_id" = $1,
+    "closed_at" = NOW()
+WHERE
+    id = $2;
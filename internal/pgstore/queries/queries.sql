@@ -1,66 +1,193 @@
 -- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "capacity", "home_currency") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "version",
+    "capacity", "home_currency"
 FROM trips
 WHERE
     id = $1;
 
--- name: UpdateTrip :exec
+-- name: LockTrip :one
+SELECT
+    "id", "owner_email", "capacity"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: UpdateTrip :execrows
 UPDATE trips
 SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "capacity" = $5,
+    "home_currency" = $6,
+    "version" = "version" + 1
 WHERE
-    id = $5;
+    id = $7 AND "version" = $8;
+
+-- name: BumpTripVersion :execrows
+UPDATE trips
+SET
+    "version" = "version" + 1
+WHERE
+    id = $1 AND "version" = $2;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "name", "invite_count", "last_invited_at", "role",
+    "waitlisted_at"
 FROM participants
 WHERE
     id = $1;
 
 -- name: ConfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = TRUE,
+    "waitlisted_at" = NULL
+WHERE
+    id = $1;
+
+-- name: WaitlistParticipant :exec
+UPDATE participants
+SET
+    "waitlisted_at" = COALESCE("waitlisted_at", NOW())
+WHERE
+    id = $1;
+
+-- name: DeclineParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = FALSE,
+    "waitlisted_at" = NULL
+WHERE
+    id = $1;
+
+-- name: CountConfirmedParticipants :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    COUNT(*)
 FROM participants
 WHERE
-    id = $1;
+    trip_id = $1 AND "is_confirmed";
+
+-- name: PromoteWaitlistedParticipants :many
+UPDATE participants
+SET
+    "is_confirmed" = TRUE,
+    "waitlisted_at" = NULL
+WHERE
+    id IN (
+        SELECT "id"
+        FROM participants AS waitlisted
+        WHERE
+            waitlisted.trip_id = $1 AND waitlisted.waitlisted_at IS NOT NULL
+        ORDER BY waitlisted.waitlisted_at, waitlisted.id
+        LIMIT $2
+    )
+RETURNING "id", "trip_id", "email", "is_confirmed", "name", "invite_count", "last_invited_at", "role", "waitlisted_at";
 
 
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+    "trips"."id" AS "trip_id", "participants"."id", "participants"."email", "participants"."is_confirmed", "participants"."name",
+    "participants"."role", "participants"."waitlisted_at"
+FROM trips
+LEFT JOIN participants ON participants.trip_id = trips.id
 WHERE
-    trip_id = $1;
+    trips.id = $1;
 
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 );
+    ( "trip_id", "email", "name" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: CreateParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email", "name", "role" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: MarkParticipantsInvited :exec
+UPDATE participants
+SET
+    "invite_count" = "invite_count" + 1,
+    "last_invited_at" = NOW()
+WHERE
+    trip_id = @trip_id
+    -- Emails may be given in another case than the one they were stored in.
+    AND lower(email) IN (SELECT lower(e) FROM unnest(@emails::text[]) AS e);
+
+-- name: ClaimParticipantInvite :execrows
+UPDATE participants
+SET
+    "invite_count" = "invite_count" + 1,
+    "last_invited_at" = NOW()
+WHERE
+    id = @id AND
+    "invite_count" < @max_invites AND
+    ("last_invited_at" IS NULL OR "last_invited_at" < NOW() - @cooldown::interval);
+
+-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1;
+
+-- name: GetParticipantEmails :many
+SELECT
+    "email"
+FROM participants
+WHERE
+    trip_id = $1;
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "capacity", "estimated_cost", "estimated_currency", "leg_id" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id";
 
 -- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "trips"."id" AS "trip_id", "activities"."id", "activities"."title", "activities"."occurs_at", "activities"."capacity",
+    "activities"."estimated_cost", "activities"."estimated_currency", "activities"."leg_id"
+FROM trips
+LEFT JOIN activities ON activities.trip_id = trips.id AND activities.status = 'scheduled'
+WHERE
+    trips.id = $1;
+
+-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "capacity", "status", "estimated_cost",
+    "estimated_currency", "leg_id"
 FROM activities
 WHERE
-    trip_id = $1;
+    id = $1 AND trip_id = $2;
+
+-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "capacity" = $3,
+    "estimated_cost" = $4,
+    "estimated_currency" = $5,
+    "leg_id" = $6,
+    "version" = "version" + 1
+WHERE
+    id = $7 AND trip_id = $8 AND "version" = $9;
+
+-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3;
 
 -- name: CreateTripLink :one
 INSERT INTO links
@@ -70,7 +197,29 @@ RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "trips"."id" AS "trip_id", "links"."id", "links"."title", "links"."url"
+FROM trips
+LEFT JOIN links ON links.trip_id = trips.id
+WHERE
+    trips.id = $1;
+
+-- name: GetTripLink :one
+SELECT
+    "id", "trip_id", "title", "url", "version"
 FROM links
 WHERE
-    trip_id = $1;
+    id = $1 AND trip_id = $2;
+
+-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "version" = "version" + 1
+WHERE
+    id = $3 AND trip_id = $4 AND "version" = $5;
+
+-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3;
@@ -0,0 +1,45 @@
+-- name: CreateReservation :one
+INSERT INTO reservations
+    ( "trip_id", "kind", "provider", "confirmation_code", "number", "starts_at", "ends_at", "start_location",
+      "end_location", "cost", "cost_currency" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+RETURNING "id";
+
+-- name: GetTripReservations :many
+SELECT
+    "id", "trip_id", "kind", "provider", "confirmation_code", "number", "starts_at", "ends_at",
+    "start_location", "end_location", "cost", "cost_currency", "version", "created_at"
+FROM reservations
+WHERE
+    trip_id = $1
+ORDER BY "starts_at", "id";
+
+-- name: GetReservation :one
+SELECT
+    "id", "trip_id", "kind", "provider", "confirmation_code", "number", "starts_at", "ends_at",
+    "start_location", "end_location", "cost", "cost_currency", "version", "created_at"
+FROM reservations
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: UpdateReservation :execrows
+UPDATE reservations
+SET
+    "kind" = $1,
+    "provider" = $2,
+    "confirmation_code" = $3,
+    "number" = $4,
+    "starts_at" = $5,
+    "ends_at" = $6,
+    "start_location" = $7,
+    "end_location" = $8,
+    "cost" = $9,
+    "cost_currency" = $10,
+    "version" = "version" + 1
+WHERE
+    id = $11 AND trip_id = $12 AND "version" = $13;
+
+-- name: DeleteReservation :execrows
+DELETE FROM reservations
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3;
@@ -0,0 +1,54 @@
+-- name: OpenTripSession :one
+INSERT INTO trip_sessions
+    ( "trip_id", "participant_id" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
+-- name: TouchTripSession :execrows
+UPDATE trip_sessions
+SET "seen_at" = NOW()
+WHERE
+    id = $1;
+
+-- name: CloseTripSession :exec
+DELETE FROM trip_sessions
+WHERE
+    id = $1;
+
+-- name: DeleteStaleTripSessions :exec
+DELETE FROM trip_sessions
+WHERE
+    seen_at < NOW() - make_interval(secs => @timeout_seconds::int);
+
+-- name: GetTripPresence :many
+SELECT DISTINCT
+    p."id", p."email", p."name", p."role"
+FROM trip_sessions s
+JOIN participants p ON p.id = s.participant_id
+WHERE
+    s.trip_id = @trip_id
+    AND s.seen_at >= NOW() - make_interval(secs => @timeout_seconds::int)
+ORDER BY p."email";
+
+-- name: ClaimActivityLock :execrows
+INSERT INTO activity_locks
+    ( "activity_id", "trip_id", "session_id", "expires_at" ) VALUES
+    ( @activity_id, @trip_id, @session_id, NOW() + make_interval(secs => @ttl_seconds::int) )
+ON CONFLICT ("activity_id") DO UPDATE
+SET "session_id" = EXCLUDED."session_id", "expires_at" = EXCLUDED."expires_at"
+WHERE
+    activity_locks.session_id = EXCLUDED.session_id OR activity_locks.expires_at <= NOW();
+
+-- name: ReleaseActivityLock :exec
+DELETE FROM activity_locks
+WHERE
+    activity_id = $1 AND session_id = $2;
+
+-- name: GetActivityLocks :many
+SELECT
+    l."activity_id", s."participant_id", l."expires_at"
+FROM activity_locks l
+JOIN trip_sessions s ON s.id = l.session_id
+WHERE
+    l.trip_id = $1 AND l.expires_at > NOW()
+ORDER BY l."activity_id";
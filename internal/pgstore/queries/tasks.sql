@@ -0,0 +1,78 @@
+-- name: CreateTask :one
+INSERT INTO tasks
+    ( "trip_id", "title", "description", "assignee_id", "due_on", "status" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: GetTripTasks :many
+SELECT
+    "id", "trip_id", "title", "description", "assignee_id", "due_on", "status", "reminded_at", "version", "created_at"
+FROM tasks
+WHERE
+    trip_id = $1
+ORDER BY "due_on" NULLS LAST, "created_at", "id";
+
+-- name: GetParticipantTasks :many
+SELECT
+    "id", "trip_id", "title", "description", "assignee_id", "due_on", "status", "reminded_at", "version", "created_at"
+FROM tasks
+WHERE
+    assignee_id = $1
+ORDER BY "due_on" NULLS LAST, "created_at", "id";
+
+-- name: GetTask :one
+SELECT
+    "id", "trip_id", "title", "description", "assignee_id", "due_on", "status", "reminded_at", "version", "created_at"
+FROM tasks
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: UpdateTask :execrows
+UPDATE tasks
+SET
+    "title" = $1,
+    "description" = $2,
+    "assignee_id" = $3,
+    "due_on" = $4,
+    "status" = $5,
+    "reminded_at" = CASE WHEN "due_on" IS DISTINCT FROM $4 THEN NULL ELSE "reminded_at" END,
+    "version" = "version" + 1
+WHERE
+    id = $6 AND trip_id = $7 AND "version" = $8;
+
+-- name: DeleteTask :execrows
+DELETE FROM tasks
+WHERE
+    id = $1 AND trip_id = $2 AND "version" = $3;
+
+-- name: ClaimOverdueTasks :many
+UPDATE tasks
+SET
+    "reminded_at" = NOW()
+WHERE
+    "status" <> 'done' AND "due_on" < CURRENT_DATE AND "reminded_at" IS NULL
+RETURNING "id", "trip_id", "title", "assignee_id", "due_on";
+
+-- name: CreateTaskComment :one
+WITH task AS (
+    UPDATE tasks
+    SET
+        "version" = "version" + 1
+    WHERE
+        id = @task_id
+    RETURNING "id"
+)
+INSERT INTO task_comments
+    ( "task_id", "author_id", "body" )
+SELECT
+    task.id, @author_id::uuid, @body::text
+FROM task
+RETURNING "id";
+
+-- name: GetTaskComments :many
+SELECT
+    "id", "task_id", "author_id", "body", "created_at"
+FROM task_comments
+WHERE
+    task_id = $1
+ORDER BY "created_at", "id";
@@ -0,0 +1,43 @@
+-- name: GetTripLegs :many
+SELECT
+    "id", "trip_id", "position", "destination", "starts_on", "ends_on", "timezone"
+FROM trip_legs
+WHERE
+    trip_id = $1
+ORDER BY "position";
+
+-- name: GetTripLeg :one
+SELECT
+    "id", "trip_id", "position", "destination", "starts_on", "ends_on", "timezone"
+FROM trip_legs
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreateTripLeg :one
+INSERT INTO trip_legs
+    ( "trip_id", "position", "destination", "starts_on", "ends_on", "timezone" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: UpdateTripLeg :execrows
+UPDATE trip_legs
+SET
+    "position" = $1,
+    "destination" = $2,
+    "starts_on" = $3,
+    "ends_on" = $4,
+    "timezone" = $5
+WHERE
+    id = $6 AND trip_id = $7;
+
+-- name: DeleteTripLegsExcept :exec
+DELETE FROM trip_legs
+WHERE
+    trip_id = @trip_id AND NOT ("id" = ANY(@keep::uuid[]));
+
+-- name: CountTripLegsOutside :one
+SELECT
+    COUNT(*)
+FROM trip_legs
+WHERE
+    trip_id = $1 AND ("starts_on" < $2 OR "ends_on" > $3);
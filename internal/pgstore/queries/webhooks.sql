@@ -0,0 +1,96 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks
+    ( "owner_email", "trip_id", "url", "events", "secret" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id";
+
+-- name: GetTripWebhooks :many
+SELECT
+    "id", "owner_email", "trip_id", "url", "events", "secret", "created_at"
+FROM webhooks
+WHERE
+    trip_id = @trip_id OR (trip_id IS NULL AND lower(owner_email) = lower(@owner_email))
+ORDER BY "created_at", "id";
+
+-- name: GetTripWebhook :one
+SELECT
+    "id", "owner_email", "trip_id", "url", "events", "secret", "created_at"
+FROM webhooks
+WHERE
+    id = @id AND (trip_id = @trip_id OR (trip_id IS NULL AND lower(owner_email) = lower(@owner_email)));
+
+-- name: DeleteTripWebhook :execrows
+DELETE FROM webhooks
+WHERE
+    id = @id AND (trip_id = @trip_id OR (trip_id IS NULL AND lower(owner_email) = lower(@owner_email)));
+
+-- name: GetWebhookDeliveries :many
+SELECT
+    d."id", d."status", d."attempts", d."next_attempt_at", d."created_at",
+    e."id" AS event_id, e."trip_id", e."type", e."subject_id", e."fields", e."created_at" AS occurred_at
+FROM webhook_deliveries d
+JOIN trip_events e ON e.id = d.event_id
+WHERE
+    d.webhook_id = @webhook_id
+ORDER BY d."created_at" DESC, d."id"
+LIMIT @max_results;
+
+-- name: GetWebhookAttempts :many
+SELECT
+    "id", "delivery_id", "status_code", "error", "duration_ms", "attempted_at"
+FROM webhook_attempts
+WHERE
+    delivery_id = ANY(@delivery_ids::uuid[])
+ORDER BY "attempted_at", "id";
+
+-- name: GetWebhookDelivery :one
+SELECT
+    "id", "webhook_id", "event_id", "status", "attempts", "next_attempt_at", "created_at"
+FROM webhook_deliveries
+WHERE
+    id = $1 AND webhook_id = $2;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries
+    ( "webhook_id", "event_id" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
+-- name: ClaimWebhookDeliveries :many
+WITH due AS (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE
+        status = 'pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT @max_results
+    FOR UPDATE SKIP LOCKED
+), claimed AS (
+    UPDATE webhook_deliveries d
+    SET "next_attempt_at" = NOW() + make_interval(secs => @lease_seconds::int)
+    FROM due
+    WHERE
+        d.id = due.id
+    RETURNING d."id", d."webhook_id", d."event_id", d."attempts"
+)
+SELECT
+    c."id", c."webhook_id", c."attempts", w."url", w."secret",
+    e."id" AS event_id, e."trip_id", e."type", e."subject_id", e."fields", e."created_at" AS occurred_at
+FROM claimed c
+JOIN webhooks w ON w.id = c.webhook_id
+JOIN trip_events e ON e.id = c.event_id
+ORDER BY e."id";
+
+-- name: RecordWebhookAttempt :exec
+WITH attempt AS (
+    INSERT INTO webhook_attempts
+        ( "delivery_id", "status_code", "error", "duration_ms" ) VALUES
+        ( @delivery_id, @status_code, @error, @duration_ms )
+)
+UPDATE webhook_deliveries
+SET
+    "status" = @status,
+    "attempts" = "attempts" + 1,
+    "next_attempt_at" = NOW() + make_interval(secs => @retry_in_seconds::int)
+WHERE
+    id = @delivery_id;
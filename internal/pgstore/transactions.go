@@ -3,8 +3,10 @@ package pgstore
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go-plann.er/internal/api/spec"
@@ -21,22 +23,30 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 	qtx := q.WithTx(tx)
 
 	tripID, err := qtx.InsertTrip(ctx, InsertTripParams{
-		Destination: params.Destination,
-		OwnerEmail:  string(params.OwnerEmail),
-		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Destination:  params.Destination,
+		OwnerEmail:   string(params.OwnerEmail),
+		OwnerName:    params.OwnerName,
+		StartsAt:     pgtype.Timestamp{Valid: true, Time: params.StartsAt},
+		EndsAt:       pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Capacity:     capacity(params.Capacity),
+		HomeCurrency: homeCurrency(params.HomeCurrency),
 	})
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for create trip: %w", err)
 	}
 
-	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
-	for i, eti := range params.EmailsToInvite {
-		participants[i] = InviteParticipantsToTripParams{
+	seen := make(map[string]struct{}, len(params.EmailsToInvite))
+	participants := make([]InviteParticipantsToTripParams, 0, len(params.EmailsToInvite))
+	for _, eti := range params.EmailsToInvite {
+		if _, ok := seen[string(eti)]; ok {
+			continue
+		}
+		seen[string(eti)] = struct{}{}
+
+		participants = append(participants, InviteParticipantsToTripParams{
 			TripID: tripID,
 			Email:  string(eti),
-		}
+		})
 	}
 
 	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
@@ -49,3 +59,885 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 
 	return tripID, nil
 }
+
+type TripOverview struct {
+	Trip         Trip
+	Participants []GetParticipantsRow
+	Activities   []GetTripActivitiesRow
+	Attendees    []GetTripActivityAttendeesRow
+	Reservations []Reservation
+	Links        []GetTripLinksRow
+	Legs         []TripLeg
+}
+
+// GetTripOverview reads a trip and everything attached to it from a single
+// read-only snapshot. It returns pgx.ErrNoRows when the trip doesn't exist.
+func (q *Queries) GetTripOverview(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (TripOverview, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to begin transaction for get trip overview: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	var overview TripOverview
+	if overview.Trip, err = qtx.GetTrip(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get trip for get trip overview: %w", err)
+	}
+
+	if overview.Participants, err = qtx.GetParticipants(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get participants for get trip overview: %w", err)
+	}
+
+	if overview.Activities, err = qtx.GetTripActivities(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get activities for get trip overview: %w", err)
+	}
+
+	if overview.Attendees, err = qtx.GetTripActivityAttendees(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get attendees for get trip overview: %w", err)
+	}
+
+	if overview.Reservations, err = qtx.GetTripReservations(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get reservations for get trip overview: %w", err)
+	}
+
+	if overview.Links, err = qtx.GetTripLinks(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get links for get trip overview: %w", err)
+	}
+
+	if overview.Legs, err = qtx.GetTripLegs(ctx, tripID); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to get legs for get trip overview: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return TripOverview{}, fmt.Errorf("pgstore: failed to commit transaction for get trip overview: %w", err)
+	}
+
+	return overview, nil
+}
+
+// InviteParticipants adds invites to a trip in a single transaction, skipping
+// emails that are already on the trip, including the owner's. It returns the
+// invites that were inserted, or pgx.ErrNoRows when the trip doesn't exist.
+func (q *Queries) InviteParticipants(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, invites []InviteParticipantsToTripParams) ([]InviteParticipantsToTripParams, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin transaction for invite participants: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	// Locking the trip serializes concurrent bulk invites, so the emails read
+	// below stay accurate until the insert.
+	trip, err := qtx.LockTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock trip for invite participants: %w", err)
+	}
+
+	emails, err := qtx.GetParticipantEmails(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get participant emails for invite participants: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(emails)+len(invites)+1)
+	seen[strings.ToLower(trip.OwnerEmail)] = struct{}{}
+	for _, email := range emails {
+		seen[strings.ToLower(email)] = struct{}{}
+	}
+
+	participants := make([]InviteParticipantsToTripParams, 0, len(invites))
+	for _, invite := range invites {
+		key := strings.ToLower(invite.Email)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		invite.TripID = tripID
+		participants = append(participants, invite)
+	}
+
+	if len(participants) == 0 {
+		return participants, nil
+	}
+
+	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to insert participants for invite participants: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for invite participants: %w", err)
+	}
+
+	return participants, nil
+}
+
+type JoinTripParams struct {
+	Code  string
+	Email string
+	Name  pgtype.Text
+}
+
+// JoinTrip registers someone as a participant through a join link and counts
+// the use against the link. It returns pgx.ErrNoRows for an unknown code,
+// ErrJoinLinkUnusable when the link can't be used anymore and
+// ErrAlreadyParticipant when the email is already on the trip.
+func (q *Queries) JoinTrip(ctx context.Context, pool *pgxpool.Pool, params JoinTripParams) (Participant, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return Participant{}, fmt.Errorf("pgstore: failed to begin transaction for join trip: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	// The lock keeps concurrent joins from going over max_uses.
+	link, err := qtx.LockJoinLink(ctx, params.Code)
+	if err != nil {
+		return Participant{}, fmt.Errorf("pgstore: failed to lock join link for join trip: %w", err)
+	}
+
+	if !link.Usable {
+		return Participant{}, ErrJoinLinkUnusable
+	}
+
+	trip, err := qtx.GetTrip(ctx, link.TripID)
+	if err != nil {
+		return Participant{}, fmt.Errorf("pgstore: failed to get trip for join trip: %w", err)
+	}
+
+	if strings.EqualFold(trip.OwnerEmail, params.Email) {
+		return Participant{}, ErrAlreadyParticipant
+	}
+
+	participant := Participant{
+		TripID: link.TripID,
+		Email:  params.Email,
+		Name:   params.Name,
+		Role:   link.Role,
+	}
+
+	participant.ID, err = qtx.CreateParticipant(ctx, CreateParticipantParams{
+		TripID: participant.TripID,
+		Email:  participant.Email,
+		Name:   participant.Name,
+		Role:   participant.Role,
+	})
+	if err != nil {
+		if IsUniqueViolation(err) {
+			return Participant{}, ErrAlreadyParticipant
+		}
+		return Participant{}, fmt.Errorf("pgstore: failed to create participant for join trip: %w", err)
+	}
+
+	if err := qtx.IncrementJoinLinkUses(ctx, link.ID); err != nil {
+		return Participant{}, fmt.Errorf("pgstore: failed to count join link use for join trip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Participant{}, fmt.Errorf("pgstore: failed to commit transaction for join trip: %w", err)
+	}
+
+	return participant, nil
+}
+
+// AcceptInvite confirms a participant, or puts them on the trip's waitlist when
+// the confirmed participants already fill its capacity. It reports whether the
+// participant was waitlisted.
+func (q *Queries) AcceptInvite(ctx context.Context, pool *pgxpool.Pool, participant Participant) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin transaction for accept invite: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	// Locking the trip serializes everything that takes or frees a seat.
+	trip, err := qtx.LockTrip(ctx, participant.TripID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to lock trip for accept invite: %w", err)
+	}
+
+	waitlisted := false
+	if trip.Capacity.Valid {
+		confirmed, err := qtx.CountConfirmedParticipants(ctx, trip.ID)
+		if err != nil {
+			return false, fmt.Errorf("pgstore: failed to count confirmed participants for accept invite: %w", err)
+		}
+		waitlisted = confirmed >= int64(trip.Capacity.Int32)
+	}
+
+	if waitlisted {
+		err = qtx.WaitlistParticipant(ctx, participant.ID)
+	} else {
+		err = qtx.ConfirmParticipant(ctx, participant.ID)
+	}
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to update participant for accept invite: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit transaction for accept invite: %w", err)
+	}
+
+	return waitlisted, nil
+}
+
+// DeclineInvite takes a participant off the confirmed list and the waitlist,
+// and promotes whoever is next in line into the freed seat. It returns the
+// promoted participants.
+func (q *Queries) DeclineInvite(ctx context.Context, pool *pgxpool.Pool, participant Participant) ([]Participant, error) {
+	return q.releaseSeat(ctx, pool, participant, "decline invite", (*Queries).DeclineParticipant)
+}
+
+// RemoveParticipant deletes a participant and promotes whoever is next in line
+// into the freed seat. It returns the promoted participants, pgx.ErrNoRows
+// when the participant was already removed, or ErrParticipantHasExpenses when
+// expenses still refer to them.
+func (q *Queries) RemoveParticipant(ctx context.Context, pool *pgxpool.Pool, participant Participant) ([]Participant, error) {
+	return q.releaseSeat(ctx, pool, participant, "remove participant", func(qtx *Queries, ctx context.Context, id uuid.UUID) error {
+		deleted, err := qtx.DeleteParticipant(ctx, id)
+		if IsForeignKeyViolation(err) {
+			return ErrParticipantHasExpenses
+		}
+		if err == nil && deleted == 0 {
+			return pgx.ErrNoRows
+		}
+		return err
+	})
+}
+
+// PromoteWaitlist fills free seats on a trip from its waitlist, e.g. after the
+// capacity was raised. It returns the promoted participants.
+func (q *Queries) PromoteWaitlist(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) ([]Participant, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin transaction for promote waitlist: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	trip, err := qtx.LockTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock trip for promote waitlist: %w", err)
+	}
+
+	promoted, err := qtx.promoteWaitlist(ctx, trip)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to promote participants for promote waitlist: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for promote waitlist: %w", err)
+	}
+
+	return promoted, nil
+}
+
+func (q *Queries) releaseSeat(ctx context.Context, pool *pgxpool.Pool, participant Participant, op string, release func(*Queries, context.Context, uuid.UUID) error) ([]Participant, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin transaction for %s: %w", op, err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	trip, err := qtx.LockTrip(ctx, participant.TripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock trip for %s: %w", op, err)
+	}
+
+	if err := release(qtx, ctx, participant.ID); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to release participant for %s: %w", op, err)
+	}
+
+	promoted, err := qtx.promoteWaitlist(ctx, trip)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to promote participants for %s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for %s: %w", op, err)
+	}
+
+	return promoted, nil
+}
+
+// promoteWaitlist confirms waitlisted participants in the order they joined
+// the waitlist until the trip is full. It must run with the trip locked.
+func (q *Queries) promoteWaitlist(ctx context.Context, trip LockTripRow) ([]Participant, error) {
+	var limit pgtype.Int8
+	if trip.Capacity.Valid {
+		confirmed, err := q.CountConfirmedParticipants(ctx, trip.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		free := int64(trip.Capacity.Int32) - confirmed
+		if free <= 0 {
+			return nil, nil
+		}
+		limit = pgtype.Int8{Int64: free, Valid: true}
+	}
+
+	return q.PromoteWaitlistedParticipants(ctx, PromoteWaitlistedParticipantsParams{
+		TripID: trip.ID,
+		Limit:  limit,
+	})
+}
+
+// AttendActivity signs a participant up for an activity of their trip. Signing
+// up twice is a no-op. It returns pgx.ErrNoRows when the activity doesn't
+// belong to the trip and ErrActivityFull when it has no seats left.
+func (q *Queries) AttendActivity(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, params AddActivityAttendeeParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin transaction for attend activity: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	// Locking the activity serializes concurrent sign-ups, so the count below
+	// can't be raced past the capacity.
+	activity, err := qtx.LockActivity(ctx, LockActivityParams{ID: params.ActivityID, TripID: tripID})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock activity for attend activity: %w", err)
+	}
+
+	if activity.Capacity.Valid {
+		count, err := qtx.CountActivityAttendees(ctx, activity.ID)
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to count attendees for attend activity: %w", err)
+		}
+
+		if count >= int64(activity.Capacity.Int32) {
+			return ErrActivityFull
+		}
+	}
+
+	added, err := qtx.AddActivityAttendee(ctx, params)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to add attendee for attend activity: %w", err)
+	}
+
+	if added == 0 {
+		return nil
+	}
+
+	if err := qtx.BumpActivityVersion(ctx, activity.ID); err != nil {
+		return fmt.Errorf("pgstore: failed to bump activity version for attend activity: %w", err)
+	}
+
+	if err := qtx.RecordTripEvent(ctx, activityAttendeesChanged(tripID, activity.ID)); err != nil {
+		return fmt.Errorf("pgstore: failed to record trip event for attend activity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for attend activity: %w", err)
+	}
+
+	return nil
+}
+
+// LeaveActivity removes a participant from an activity of their trip. It
+// returns pgx.ErrNoRows when the activity doesn't belong to the trip and
+// ErrNotAttending when the participant wasn't signed up.
+func (q *Queries) LeaveActivity(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, params RemoveActivityAttendeeParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin transaction for leave activity: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	activity, err := qtx.LockActivity(ctx, LockActivityParams{ID: params.ActivityID, TripID: tripID})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock activity for leave activity: %w", err)
+	}
+
+	removed, err := qtx.RemoveActivityAttendee(ctx, params)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to remove attendee for leave activity: %w", err)
+	}
+
+	if removed == 0 {
+		return ErrNotAttending
+	}
+
+	if err := qtx.BumpActivityVersion(ctx, activity.ID); err != nil {
+		return fmt.Errorf("pgstore: failed to bump activity version for leave activity: %w", err)
+	}
+
+	if err := qtx.RecordTripEvent(ctx, activityAttendeesChanged(tripID, activity.ID)); err != nil {
+		return fmt.Errorf("pgstore: failed to record trip event for leave activity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for leave activity: %w", err)
+	}
+
+	return nil
+}
+
+// activityAttendeesChanged is the event logged when someone signs up for an
+// activity or leaves it. The triggers of migration 023 don't see it, attendees
+// live in a table of their own and the activity row only has its version
+// bumped.
+func activityAttendeesChanged(tripID, activityID uuid.UUID) RecordTripEventParams {
+	return RecordTripEventParams{
+		TripID:    tripID,
+		Type:      "activity.updated",
+		SubjectID: activityID,
+		Fields:    []string{"attendees"},
+	}
+}
+
+// PollOptionParams describes a candidate of a poll: a proposed activity for
+// activity polls, or a date range for date polls.
+type PollOptionParams struct {
+	Title    string
+	OccursAt pgtype.Timestamp
+	StartsAt pgtype.Timestamp
+	EndsAt   pgtype.Timestamp
+}
+
+// OpenPoll creates a poll along with its first options. Options of an activity
+// poll are stored as proposed activities, kept out of the itinerary until the
+// poll is closed.
+func (q *Queries) OpenPoll(ctx context.Context, pool *pgxpool.Pool, params CreatePollParams, options []PollOptionParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin transaction for open poll: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	pollID, err := qtx.CreatePoll(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to create poll for open poll: %w", err)
+	}
+
+	poll := Poll{ID: pollID, TripID: params.TripID, Kind: params.Kind}
+	if _, err := qtx.addPollOptions(ctx, poll, options, "open poll"); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for open poll: %w", err)
+	}
+
+	return pollID, nil
+}
+
+// AddPollOptions adds options to an open poll. It returns pgx.ErrNoRows when
+// the poll doesn't belong to the trip and ErrPollClosed once it was closed.
+func (q *Queries) AddPollOptions(ctx context.Context, pool *pgxpool.Pool, params LockPollParams, options []PollOptionParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin transaction for add poll options: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	poll, err := qtx.LockPoll(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock poll for add poll options: %w", err)
+	}
+
+	if poll.ClosedAt.Valid {
+		return nil, ErrPollClosed
+	}
+
+	ids, err := qtx.addPollOptions(ctx, poll, options, "add poll options")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for add poll options: %w", err)
+	}
+
+	return ids, nil
+}
+
+// CastBallot replaces a participant's votes on a poll. It returns
+// pgx.ErrNoRows when the poll doesn't belong to the trip, ErrPollClosed once it
+// was closed and ErrUnknownPollOption when a vote targets another poll.
+func (q *Queries) CastBallot(ctx context.Context, pool *pgxpool.Pool, params LockPollParams, participantID uuid.UUID, votes []CastPollVoteParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin transaction for cast ballot: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	poll, err := qtx.LockPoll(ctx, params)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock poll for cast ballot: %w", err)
+	}
+
+	if poll.ClosedAt.Valid {
+		return ErrPollClosed
+	}
+
+	if err := qtx.DeletePollVotes(ctx, DeletePollVotesParams{ParticipantID: participantID, PollID: poll.ID}); err != nil {
+		return fmt.Errorf("pgstore: failed to delete votes for cast ballot: %w", err)
+	}
+
+	for _, vote := range votes {
+		vote.ParticipantID = participantID
+		vote.PollID = poll.ID
+
+		cast, err := qtx.CastPollVote(ctx, vote)
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to cast vote for cast ballot: %w", err)
+		}
+
+		if cast == 0 {
+			return ErrUnknownPollOption
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for cast ballot: %w", err)
+	}
+
+	return nil
+}
+
+// ClosePoll closes a poll and applies its winner, the option with the best
+// score and then the most rank points. A winning activity is scheduled and
+// the other proposals rejected, winning dates are applied to the trip. It
+// returns pgx.ErrNoRows when the poll doesn't belong to the trip,
+// ErrPollClosed when it was already closed, ErrPollEmpty when it has no
+// options and ErrRouteOutsideTrip when winning dates would leave legs of the
+// trip outside of it.
+func (q *Queries) ClosePoll(ctx context.Context, pool *pgxpool.Pool, params LockPollParams) (GetPollOptionsRow, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to begin transaction for close poll: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	poll, err := qtx.LockPoll(ctx, params)
+	if err != nil {
+		return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to lock poll for close poll: %w", err)
+	}
+
+	if poll.ClosedAt.Valid {
+		return GetPollOptionsRow{}, ErrPollClosed
+	}
+
+	options, err := qtx.GetPollOptions(ctx, poll.ID)
+	if err != nil {
+		return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to get options for close poll: %w", err)
+	}
+
+	if len(options) == 0 {
+		return GetPollOptionsRow{}, ErrPollEmpty
+	}
+
+	// Options come ordered by standing, so the first one won.
+	winner := options[0]
+	if winner.ActivityID.Valid {
+		if err := qtx.ScheduleActivity(ctx, winner.ActivityID.Bytes); err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to schedule activity for close poll: %w", err)
+		}
+
+		if err := qtx.RejectPollActivities(ctx, poll.ID); err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to reject activities for close poll: %w", err)
+		}
+	} else {
+		if _, err := qtx.LockTrip(ctx, poll.TripID); err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to lock trip for close poll: %w", err)
+		}
+
+		trip, err := qtx.GetTrip(ctx, poll.TripID)
+		if err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to get trip for close poll: %w", err)
+		}
+
+		outside, err := qtx.CountTripLegsOutside(ctx, CountTripLegsOutsideParams{
+			TripID:   trip.ID,
+			StartsOn: pgtype.Date{Time: winner.StartsAt.Time, Valid: true},
+			EndsOn:   pgtype.Date{Time: winner.EndsAt.Time, Valid: true},
+		})
+		if err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to check legs for close poll: %w", err)
+		}
+
+		if outside > 0 {
+			return GetPollOptionsRow{}, ErrRouteOutsideTrip
+		}
+
+		if _, err := qtx.UpdateTrip(ctx, UpdateTripParams{
+			Destination:  trip.Destination,
+			EndsAt:       winner.EndsAt,
+			StartsAt:     winner.StartsAt,
+			IsConfirmed:  trip.IsConfirmed,
+			Capacity:     trip.Capacity,
+			HomeCurrency: trip.HomeCurrency,
+			ID:           trip.ID,
+			Version:      trip.Version,
+		}); err != nil {
+			return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to update trip for close poll: %w", err)
+		}
+	}
+
+	if err := qtx.SetPollWinner(ctx, SetPollWinnerParams{
+		WinnerID: pgtype.UUID{Bytes: winner.ID, Valid: true},
+		ID:       poll.ID,
+	}); err != nil {
+		return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to set winner for close poll: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return GetPollOptionsRow{}, fmt.Errorf("pgstore: failed to commit transaction for close poll: %w", err)
+	}
+
+	return winner, nil
+}
+
+func (q *Queries) addPollOptions(ctx context.Context, poll Poll, options []PollOptionParams, op string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(options))
+	for _, option := range options {
+		params := CreatePollOptionParams{PollID: poll.ID}
+		if poll.Kind == spec.PollKindDates.ToValue() {
+			params.StartsAt = option.StartsAt
+			params.EndsAt = option.EndsAt
+		} else {
+			activityID, err := q.CreateProposedActivity(ctx, CreateProposedActivityParams{
+				TripID:   poll.TripID,
+				Title:    option.Title,
+				OccursAt: option.OccursAt,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("pgstore: failed to create proposed activity for %s: %w", op, err)
+			}
+			params.ActivityID = pgtype.UUID{Bytes: activityID, Valid: true}
+		}
+
+		id, err := q.CreatePollOption(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to create poll option for %s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// LogExpense stores an expense along with how it is split, so an expense is
+// never visible without its splits.
+func (q *Queries) LogExpense(ctx context.Context, pool *pgxpool.Pool, params CreateExpenseParams, splits []CreateExpenseSplitsParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin transaction for log expense: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	expenseID, err := qtx.CreateExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to create expense for log expense: %w", err)
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	if _, err := qtx.CreateExpenseSplits(ctx, splits); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to create splits for log expense: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for log expense: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+// SaveExchangeRates stores rates from one source, replacing any rate already
+// known for the same pair and day.
+func (q *Queries) SaveExchangeRates(ctx context.Context, pool *pgxpool.Pool, rates []UpsertExchangeRateParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin transaction for save exchange rates: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	for _, rate := range rates {
+		if err := qtx.UpsertExchangeRate(ctx, rate); err != nil {
+			return fmt.Errorf("pgstore: failed to upsert exchange rate for save exchange rates: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit transaction for save exchange rates: %w", err)
+	}
+
+	return nil
+}
+
+func capacity(c *int) pgtype.Int4 {
+	if c == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*c), Valid: true}
+}
+
+func homeCurrency(c *string) pgtype.Text {
+	if c == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *c, Valid: true}
+}
+
+// ReplaceTripLegs replaces the route of a trip with legs, in order. Legs with
+// an ID are updated in place, keeping the activities assigned to them, the
+// others are created, and legs left out are deleted. The trip version is
+// bumped since the route is part of the trip. It returns ErrVersionMismatch
+// when the trip changed since version was read and ErrUnknownTripLeg when an
+// ID isn't a leg of the trip.
+func (q *Queries) ReplaceTripLegs(ctx context.Context, pool *pgxpool.Pool, params BumpTripVersionParams, legs []TripLeg) ([]TripLeg, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin transaction for replace trip legs: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	bumped, err := qtx.BumpTripVersion(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to bump trip version for replace trip legs: %w", err)
+	}
+
+	if bumped == 0 {
+		return nil, ErrVersionMismatch
+	}
+
+	keep := make([]uuid.UUID, 0, len(legs))
+	for _, leg := range legs {
+		if leg.ID != uuid.Nil {
+			keep = append(keep, leg.ID)
+		}
+	}
+
+	if err := qtx.DeleteTripLegsExcept(ctx, DeleteTripLegsExceptParams{TripID: params.ID, Keep: keep}); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to delete legs for replace trip legs: %w", err)
+	}
+
+	for i, leg := range legs {
+		if leg.ID == uuid.Nil {
+			if _, err := qtx.CreateTripLeg(ctx, CreateTripLegParams{
+				TripID:      params.ID,
+				Position:    int32(i),
+				Destination: leg.Destination,
+				StartsOn:    leg.StartsOn,
+				EndsOn:      leg.EndsOn,
+				Timezone:    leg.Timezone,
+			}); err != nil {
+				return nil, fmt.Errorf("pgstore: failed to create leg for replace trip legs: %w", err)
+			}
+			continue
+		}
+
+		updated, err := qtx.UpdateTripLeg(ctx, UpdateTripLegParams{
+			Position:    int32(i),
+			Destination: leg.Destination,
+			StartsOn:    leg.StartsOn,
+			EndsOn:      leg.EndsOn,
+			Timezone:    leg.Timezone,
+			ID:          leg.ID,
+			TripID:      params.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to update leg for replace trip legs: %w", err)
+		}
+
+		if updated == 0 {
+			return nil, ErrUnknownTripLeg
+		}
+	}
+
+	route, err := qtx.GetTripLegs(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get legs for replace trip legs: %w", err)
+	}
+
+	// Only the version of the trip row changed, which isn't logged by itself.
+	if err := qtx.RecordTripEvent(ctx, RecordTripEventParams{
+		TripID:    params.ID,
+		Type:      "trip.updated",
+		SubjectID: params.ID,
+		Fields:    []string{"legs"},
+	}); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to record trip event for replace trip legs: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit transaction for replace trip legs: %w", err)
+	}
+
+	return route, nil
+}
+
+// CreateChecklistWithItems creates a checklist along with its first items, in
+// order, e.g. the items of a template.
+func (q *Queries) CreateChecklistWithItems(ctx context.Context, pool *pgxpool.Pool, params CreateChecklistParams, items []string) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin transaction for create checklist: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	qtx := q.WithTx(tx)
+
+	checklistID, err := qtx.CreateChecklist(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to create checklist for create checklist: %w", err)
+	}
+
+	if len(items) > 0 {
+		if err := qtx.CreateChecklistItems(ctx, CreateChecklistItemsParams{ChecklistID: checklistID, Texts: items}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to create items for create checklist: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit transaction for create checklist: %w", err)
+	}
+
+	return checklistID, nil
+}